@@ -6,14 +6,37 @@ import (
 	"zerginator/globals"
 )
 
-// EvaluatePosition evaluates the current board position and returns a score
-func EvaluatePosition() int {
+// WinScore is the score of a won game, it is above any material and positional score
+const WinScore = 50000
+
+// ResultScore returns the score of a game result from the side to move's perspective
+func ResultScore(pos *board.Position, result board.GameResult) int {
+	score := 0
+	switch result {
+	case board.WhiteWins:
+		score = WinScore
+	case board.BlackWins:
+		score = -WinScore
+	}
+	if pos.SideToMove == globals.BLACK {
+		score *= -1
+	}
+	return score
+}
+
+// EvaluatePosition evaluates the given board position and returns a score from the side to move's perspective
+func EvaluatePosition(pos *board.Position) int {
+	// a finished game is scored by its result alone
+	if result, _ := pos.TerminalResult(); result != board.Ongoing {
+		return ResultScore(pos, result)
+	}
 	score := 0
 	for p := globals.WhitePawn; p <= globals.BlackPawn; p++ {
-		bitboard := globals.Bitboards[p]
+		bitboard := pos.Bitboards[p]
 		for bitboard != 0 {
 			piece := p
-			square := bitoperations.GetLeastSignificantBitIndex(bitboard)
+			// pop the least significant bit
+			square := bitoperations.PopLSB(&bitboard)
 			// add the material value of the piece to the score
 			score += globals.MaterialValues[piece]
 
@@ -21,85 +44,77 @@ func EvaluatePosition() int {
 			switch {
 			case p == globals.WhitePawn:
 				// positional score
-				score += globals.PawnPositionalValues[square]
+				score += globals.PawnPositionalValues[globals.ReferenceSquare[square]]
 				// double pawn penalty
-				doublePawns := bitoperations.CountBits(globals.Bitboards[globals.WhitePawn] & globals.FileMasks[square])
+				doublePawns := bitoperations.CountBits(pos.Bitboards[globals.WhitePawn] & globals.FileMasks[square])
 				if doublePawns > 1 {
 					score += globals.DoublePawnPenalty * doublePawns
 				}
 				// isolated pawn penalty
-				if globals.Bitboards[globals.WhitePawn]&globals.IsolatedMasks[square] == 0 {
+				if pos.Bitboards[globals.WhitePawn]&globals.IsolatedMasks[square] == 0 {
 					score += globals.IsolatedPawnPenalty
 				}
 				// passed pawn bonus
-				if globals.WhitePassedMasks[square]&globals.Bitboards[globals.BlackPawn] == 0 {
+				if globals.WhitePassedMasks[square]&pos.Bitboards[globals.BlackPawn] == 0 {
 					score += globals.PassedPawnBonus[globals.GetRankFromSquare[square]]
 				}
 			case p == globals.WhiteKnight:
-				score += globals.KnightPositionalValues[square]
+				score += globals.KnightPositionalValues[globals.ReferenceSquare[square]]
 			case p == globals.WhiteBishop:
 				// positional score
-				score += globals.BishopPositionalValues[square]
+				score += globals.BishopPositionalValues[globals.ReferenceSquare[square]]
 				// mobility score
-				score += bitoperations.CountBits(board.GetBishopAttacks(square, globals.Occupancies[globals.BOTH]))
+				score += bitoperations.CountBits(board.GetBishopAttacks(square, pos.Occupancies[globals.BOTH]))
 			case p == globals.WhiteRook:
 				// positional score
-				score += globals.RookPositionalValues[square]
+				score += globals.RookPositionalValues[globals.ReferenceSquare[square]]
 				// semi-open file score
-				if globals.Bitboards[globals.WhitePawn]&globals.FileMasks[square] == 0 {
+				if pos.Bitboards[globals.WhitePawn]&globals.FileMasks[square] == 0 {
 					score += globals.SemiOpenFileScore
 				}
 				// open file score
-				if (globals.Bitboards[globals.WhitePawn]|globals.Bitboards[globals.BlackPawn])&globals.FileMasks[square] == 0 {
+				if (pos.Bitboards[globals.WhitePawn]|pos.Bitboards[globals.BlackPawn])&globals.FileMasks[square] == 0 {
 					score += globals.OpenFileScore
 				}
+			case p == globals.WhiteQueen:
+				// positional score
+				score += globals.QueenPositionalValues[globals.ReferenceSquare[square]]
+				// mobility score
+				score += bitoperations.CountBits(board.GetQueenAttacks(square, pos.Occupancies[globals.BOTH]))
 			case p == globals.WhiteKing:
-				score += globals.KingPositionalValues[square]
+				score += globals.KingPositionalValues[globals.ReferenceSquare[square]]
 			case p == globals.BlackPawn:
 				// positional score
-				score -= globals.PawnPositionalValues[globals.MirrorSquare[square]]
+				score -= globals.PawnPositionalValues[globals.ReferenceSquare[globals.MirrorSquare[square]]]
 				// double pawn penalty
-				doublePawns := bitoperations.CountBits(globals.Bitboards[globals.BlackPawn] & globals.FileMasks[square])
+				doublePawns := bitoperations.CountBits(pos.Bitboards[globals.BlackPawn] & globals.FileMasks[square])
 				if doublePawns > 1 {
 					score -= globals.DoublePawnPenalty * doublePawns
 				}
 				// isolated pawn penalty
-				if globals.Bitboards[globals.BlackPawn]&globals.IsolatedMasks[square] == 0 {
+				if pos.Bitboards[globals.BlackPawn]&globals.IsolatedMasks[square] == 0 {
 					score -= globals.IsolatedPawnPenalty
 				}
-				// win condition for black
-				if square >= globals.A1 && square <= globals.E1 {
-					score -= 50000
-				}
-				// passed pawn bonus
-				if globals.BlackPassedMasks[square]&globals.Bitboards[globals.WhitePawn] == 0 {
-					score -= globals.PassedPawnBonus[globals.GetRankFromSquare[globals.MirrorSquare[square]]]
+				// passed pawn bonus, a goal rank above rank 1 brings the pawn closer to its goal
+				if globals.BlackPassedMasks[square]&pos.Bitboards[globals.WhitePawn] == 0 {
+					advance := globals.GetRankFromSquare[globals.MirrorSquare[square]] + board.ActiveVariant.GoalRank - 1
+					score -= globals.PassedPawnBonus[min(advance, len(globals.PassedPawnBonus)-1)]
 				}
 			}
-			// pop the least significant bit
-			bitoperations.PopBit(&bitboard, square)
 		}
 	}
-	// no white pieces so black wins
-	if globals.Occupancies[globals.WHITE] == 0 {
-		score -= 50000
-	}
-	// no black pieces so white wins
-	if globals.Occupancies[globals.BLACK] == 0 {
-		score += 50000
-	}
-	if globals.SideToMove == globals.BLACK {
+	if pos.SideToMove == globals.BLACK {
 		score *= -1
 	}
 	return score
 }
 
 // SetFileRankMask returns the file and rank masks
-func SetFileRankMask(fileNumber int, rankNumber int) uint64 {
-	var mask uint64
-	for rank := 0; rank < 8; rank++ {
-		for file := 0; file < 5; file++ {
-			square := rank*5 + file
+func SetFileRankMask(fileNumber int, rankNumber int) globals.Bitboard {
+	var mask globals.Bitboard
+	for rank := 0; rank < globals.Geometry.Ranks; rank++ {
+		for file := 0; file < globals.Geometry.Files; file++ {
+			square := globals.Geometry.Square(rank, file)
 			if -1 != fileNumber && file == fileNumber {
 				bitoperations.SetBit(&mask, square)
 			} else if -1 != rankNumber && rank == rankNumber {
@@ -113,9 +128,9 @@ func SetFileRankMask(fileNumber int, rankNumber int) uint64 {
 
 // InitPawnEvaluationMasks initializes the pawn evaluation masks
 func InitPawnEvaluationMasks() {
-	for rank := 0; rank < 8; rank++ {
-		for file := 0; file < 5; file++ {
-			square := rank*5 + file
+	for rank := 0; rank < globals.Geometry.Ranks; rank++ {
+		for file := 0; file < globals.Geometry.Files; file++ {
+			square := globals.Geometry.Square(rank, file)
 			// set the file masks
 			globals.FileMasks[square] |= SetFileRankMask(file, -1)
 			// set the rank masks
@@ -127,22 +142,22 @@ func InitPawnEvaluationMasks() {
 	}
 
 	// set the passed masks after setting the other masks
-	for rank := 0; rank < 8; rank++ {
-		for file := 0; file < 5; file++ {
-			square := rank*5 + file
+	for rank := 0; rank < globals.Geometry.Ranks; rank++ {
+		for file := 0; file < globals.Geometry.Files; file++ {
+			square := globals.Geometry.Square(rank, file)
 			// set the black passed masks
 			globals.BlackPassedMasks[square] |= SetFileRankMask(file-1, rank)
 			globals.BlackPassedMasks[square] |= SetFileRankMask(file, rank)
 			globals.BlackPassedMasks[square] |= SetFileRankMask(file+1, rank)
 			for i := 0; i < rank+1; i++ {
-				globals.BlackPassedMasks[square] &= ^globals.RankMasks[i*5+file]
+				globals.BlackPassedMasks[square] &= ^globals.RankMasks[globals.Geometry.Square(i, file)]
 			}
 			// set the white passed masks
 			globals.WhitePassedMasks[square] |= SetFileRankMask(file-1, -1)
 			globals.WhitePassedMasks[square] |= SetFileRankMask(file, -1)
 			globals.WhitePassedMasks[square] |= SetFileRankMask(file+1, -1)
-			for i := 0; i < (8 - rank); i++ {
-				globals.WhitePassedMasks[square] &= ^globals.RankMasks[(7-i)*5+file]
+			for i := 0; i < (globals.Geometry.Ranks - rank); i++ {
+				globals.WhitePassedMasks[square] &= ^globals.RankMasks[globals.Geometry.Square(globals.Geometry.Ranks-1-i, file)]
 			}
 		}
 	}
@@ -0,0 +1,48 @@
+package ai
+
+import (
+	"testing"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+func TestMirrorEvaluation(t *testing.T) {
+	// a position and its left to right mirror image have the same static evaluation
+	for _, test := range []struct {
+		fen, mirror string
+	}{
+		{globals.FenDebugStartPosition, "ppppp/ppppp/ppppp/5/5/5/PPPPP/B1KNR w -"},
+		{"ppppp/ppppp/ppppp/5/5/5/PPPPP/1RKBN w -", "ppppp/ppppp/ppppp/5/5/5/PPPPP/NBKR1 w -"},
+		{globals.FenDebug2, "ppppp/p1ppp/1p2p/ppppP/3P1/P1NR1/BPP2/2K2 w -"},
+		{globals.FenDebug3, "ppppp/p1ppp/1p2p/pNppP/1P1P1/P2R1/B1P2/2K2 b b3"},
+		{globals.FenDebug4, "3p1/2P2/3RP/pP3/3pP/2B2/1p3/K1PN1 b e3"},
+	} {
+		pos, mirrored := board.NewPosition(), board.NewPosition()
+		if err := pos.ParseFEN(test.fen); err != nil {
+			t.Fatal(err)
+		}
+		if err := mirrored.ParseFEN(test.mirror); err != nil {
+			t.Fatal(err)
+		}
+		if score, mirrorScore := EvaluatePosition(pos), EvaluatePosition(mirrored); score != mirrorScore {
+			t.Errorf("%q evaluates to %d, its mirror image %q to %d", test.fen, score, test.mirror, mirrorScore)
+		}
+	}
+}
+
+func TestMirrorEvaluationOfSelfTestPositions(t *testing.T) {
+	fens, err := board.SelfTestPositions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pos := board.NewPosition()
+	for _, fen := range fens {
+		if err := pos.ParseFEN(fen); err != nil {
+			t.Fatal(err)
+		}
+		mirrored := pos.Mirrored()
+		if score, mirrorScore := EvaluatePosition(pos), EvaluatePosition(mirrored); score != mirrorScore {
+			t.Errorf("%q evaluates to %d, its mirror image %q to %d", fen, score, mirrored.ToFEN(), mirrorScore)
+		}
+	}
+}
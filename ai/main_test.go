@@ -0,0 +1,18 @@
+package ai
+
+import (
+	"os"
+	"testing"
+	"zerginator/board"
+)
+
+// testHashEntries is the size of the transposition tables of the searchers made by the tests
+const testHashEntries = 1 << 16
+
+// TestMain initialises the attack tables, hash keys and evaluation masks of the default board before the tests run
+func TestMain(m *testing.M) {
+	board.InitAttackTables()
+	board.InitRandomKeys()
+	InitPawnEvaluationMasks()
+	os.Exit(m.Run())
+}
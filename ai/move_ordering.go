@@ -2,98 +2,109 @@ package ai
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"zerginator/board"
 	"zerginator/globals"
 )
 
 /*
 	In this file an implementation of move ordering to enhance the search efficiency of the negamax
-	search. This will help create beta cutoffs earlier in the search tree. This also holds the killer
-	move and history heuristic tables. The transposition table is also implemented here.
+	search. This will help create beta cutoffs earlier in the search tree. The killer move and history
+	heuristic tables and the transposition table belong to the Searcher, the scoring and probing of them
+	is implemented here.
 
 	This incorporates the most valuable victim - least valuable attacker (MVV-LVA) heuristic
-		(Victim)|  Pawn | Knight | Bishop | Rook | King
+		(Victim)|  Pawn | Knight | Bishop | Rook | Queen | King
 	(Attacker)	|
-		Pawn	|	104		204		304		404		504
-		Knight	|	103		203		303		403		503
-		Bishop	|	102		202		302		402		502
-		Rook	|	101		201		301		401		501
-		King	|	100		200		300		400		500
+		Pawn	|	105		205		305		405		605		505
+		Knight	|	104		204		304		404		604		504
+		Bishop	|	103		203		303		403		603		503
+		Rook	|	102		202		302		402		602		502
+		Queen	|	101		201		301		401		601		501
+		King	|	100		200		300		400		600		500
 
 	A typical ordering of moves would be:
 	1. TT entry
 	2. PV move
-	3. Captures (ordered by MVV-LVA)
+	3. Captures and promotions (ordered by MVV-LVA)
 	4. 1st Killer move
 	5. 2nd Killer move
 	6. History heuristic moves
 	7. Unsorted moves
+	The search gets its moves in this order from the MovePicker, see move_picker.go.
 */
 
-var MvvLvaScores = [6][10]int{
-	{104, 204, 304, 404, 504, 104, 204, 304, 404, 504},
-	{103, 203, 303, 403, 503, 103, 203, 203, 403, 503},
-	{102, 202, 302, 402, 502, 102, 303, 302, 402, 502},
-	{101, 201, 301, 401, 501, 101, 401, 401, 401, 501},
-	{100, 200, 300, 400, 500, 104, 503, 502, 400, 500},
-	{104, 204, 304, 404, 504, 104, 202, 303, 404, 504}}
-
-// KillerMoves stores the killer moves with [id][ply]
-var KillerMoves [2][64]uint64
-
-// HistoryHeuristic stores the history heuristic scores for moves with [piece][square]
-var HistoryHeuristic [6][40]uint64
-
-func ScoreMove(move uint64) int {
-	// score the principle variation move highest if we are following the PV
-	if ScorePV {
-		if PVTable[0][globals.Ply] == move {
-			ScorePV = false
-			return 20000
+// MvvLvaScores holds the capture scores [attacker][victim], the most valuable victim taken by the least valuable
+// attacker first
+var MvvLvaScores = [globals.NoPiece][globals.NoPiece]int{
+	{105, 205, 305, 405, 605, 505, 105},
+	{104, 204, 304, 404, 604, 504, 104},
+	{103, 203, 303, 403, 603, 503, 103},
+	{102, 202, 302, 402, 602, 502, 102},
+	{101, 201, 301, 401, 601, 501, 101},
+	{100, 200, 300, 400, 600, 500, 100},
+	{105, 205, 305, 405, 605, 505, 105}}
+
+// ScoreMove returns the ordering score of the move in the given position
+func (s *Searcher) ScoreMove(pos *board.Position, move board.Move) int {
+	if move.IsPromotion() {
+		// promotions are ordered with the captures, by the value of the promoted piece
+		score := globals.MaterialValues[move.Promoted()] + 10000
+		if move.IsCapture() {
+			score += MvvLvaScores[move.Piece()][move.Captured()]
 		}
+		return score
 	}
-	if board.GetMoveCapturedPiece(move) >= globals.WhitePawn && board.GetMoveCapturedPiece(move) <= globals.BlackPawn {
+	if move.IsCapture() {
 		// return the MVV-LVA score [source_square][target_piece]
-		return MvvLvaScores[board.GetMovePiece(move)][board.GetMoveCapturedPiece(move)] + 10000
+		return MvvLvaScores[move.Piece()][move.Captured()] + 10000
 	} else {
 		// score quiet moves
-		if KillerMoves[0][globals.Ply] == move {
+		if s.KillerMoves[0][pos.Ply] == move {
 			return 9000
-		} else if KillerMoves[1][globals.Ply] == move {
+		} else if s.KillerMoves[1][pos.Ply] == move {
 			return 8000
 		} else {
-			return int(HistoryHeuristic[board.GetMovePiece(move)][board.GetMoveTarget(move)])
+			return int(s.HistoryHeuristic[move.Piece()][move.Target()])
 		}
 	}
 }
 
-// EnablePVScore enables the principal variation scoring
-func EnablePVScore(moveList *board.Moves) {
-	FollowPV = false
-	for i := 0; i < moveList.Count; i++ {
-		if PVTable[0][globals.Ply] == moveList.Moves[i] {
-			ScorePV = true
-			FollowPV = true
-		}
+// PVMove returns the principal variation move of the current ply, or NoMove when it cannot be played in the
+// position, in which case the search stops following the principal variation
+func (s *Searcher) PVMove(pos *board.Position) board.Move {
+	move := s.PVTable[0][pos.Ply]
+	s.FollowPV = pos.IsPseudoLegal(move)
+	if !s.FollowPV {
+		return board.NoMove
 	}
+	return move
 }
 
-func PrintMoveScores(moveList *board.Moves) {
-	fmt.Printf("\n\tMove | Score\n")
+// PrintMoveScores prints every move of the move list along with its ordering score
+func (s *Searcher) PrintMoveScores(pos *board.Position, moveList *board.Moves) {
+	s.WriteMoveScores(os.Stdout, pos, moveList)
+}
+
+// WriteMoveScores writes every move of the move list along with its ordering score to w
+func (s *Searcher) WriteMoveScores(w io.Writer, pos *board.Position, moveList *board.Moves) {
+	fmt.Fprintf(w, "\n\tMove | Score\n")
 	for i := 0; i < moveList.Count; i++ {
 		move := moveList.Moves[i]
-		board.PrintMove(move)
-		fmt.Printf(" | %d\n", ScoreMove(move))
+		board.WriteMove(w, move)
+		fmt.Fprintf(w, " | %d\n", s.ScoreMove(pos, move))
 	}
 }
 
-func OrderMoves(moveList *board.Moves, bestMove uint64) {
-	var moveScores []int
+// OrderMoves sorts the move list so that the most promising moves are searched first
+func (s *Searcher) OrderMoves(pos *board.Position, moveList *board.Moves, bestMove board.Move) {
+	var moveScores [len(moveList.Moves)]int
 	for i := 0; i < moveList.Count; i++ {
 		if bestMove == moveList.Moves[i] {
-			moveScores = append(moveScores, 30000)
+			moveScores[i] = 30000
 		} else {
-			moveScores = append(moveScores, ScoreMove(moveList.Moves[i]))
+			moveScores[i] = s.ScoreMove(pos, moveList.Moves[i])
 		}
 	}
 	// simple bubble sort, as move lists are short
@@ -117,8 +128,8 @@ func OrderMoves(moveList *board.Moves, bestMove uint64) {
 // noHashEntry indicates that there is no valid entry in the hash table
 var noHashEntry = 10000000
 
-// hash table size
-var hashSize = 0x400000 // 4 million entries
+// DefaultHashEntries is the number of entries of the transposition table the engine plays with
+const DefaultHashEntries = 0x400000 // 4 million entries
 
 // Transposition Table flags
 const (
@@ -127,38 +138,48 @@ const (
 	HashFlagBeta
 )
 
-// taggedHashEntry represents an entry in the transposition table
+// taggedHashEntry represents an entry in the transposition table. A position and its left to right mirror image share
+// an entry under their canonical key, its best move is stored as it is played in the position whose hash key is the
+// canonical key
 type taggedHashEntry struct {
-	key      uint64 // position hash key
-	depth    int    // current search depth
-	flags    int    // node flag: score>=beta, score<=alpha, score>alpha
-	value    int    // score for the position
-	bestMove uint64 // best move for the position
+	key      uint64     // canonical key of the position
+	depth    int        // current search depth
+	flags    int        // node flag: score>=beta, score<=alpha, score>alpha
+	value    int        // score for the position
+	bestMove board.Move // best move for the position
 }
 
-// transpositionTable is the transposition table
-var transpositionTable = make([]taggedHashEntry, hashSize)
-
 // ClearTranspositionTable clears the transposition table
-func ClearTranspositionTable() {
-	for i := 0; i < hashSize; i++ {
+func (s *Searcher) ClearTranspositionTable() {
+	for i := range s.transpositionTable {
 		// reset entry
-		transpositionTable[i].key = 0
-		transpositionTable[i].depth = 0
-		transpositionTable[i].flags = 0
-		transpositionTable[i].value = 0
+		s.transpositionTable[i].key = 0
+		s.transpositionTable[i].depth = 0
+		s.transpositionTable[i].flags = 0
+		s.transpositionTable[i].value = 0
 	}
 }
 
+// tableKey returns the key the position is stored under in the transposition table, its canonical key, and true if
+// that is the key of its mirror image, in which case the stored best move is mirrored
+func tableKey(pos *board.Position) (uint64, bool) {
+	key := pos.CanonicalKey()
+	return key, key != pos.HashKey
+}
+
 // ProbeTranspositionTable checks the TT and returns either a stored value/bound or noHashEntry.
 // It ensures the caller's bestMove pointer is updated when a TT entry (even shallow) exists.
-func ProbeTranspositionTable(bestMove *uint64, depth int, alpha int, beta int) int {
-	//Create a pointer to point to the entry in the transposition table based on the current board hash key
-	hashEntry := &transpositionTable[globals.HashKey%uint64(hashSize)]
-	if hashEntry.key == globals.HashKey {
-		// provide the best move if it is not nil
+func (s *Searcher) ProbeTranspositionTable(pos *board.Position, bestMove *board.Move, depth int, alpha int, beta int) int {
+	//Create a pointer to point to the entry in the transposition table based on the canonical key of the board
+	key, mirrored := tableKey(pos)
+	hashEntry := &s.transpositionTable[key%uint64(len(s.transpositionTable))]
+	if hashEntry.key == key {
+		// provide the best move if it is not nil, as it is played in this position
 		if bestMove != nil {
 			*bestMove = hashEntry.bestMove
+			if mirrored {
+				*bestMove = board.MirrorMove(hashEntry.bestMove)
+			}
 		}
 		// only use stored value if it was searched to at least the requested depth
 		if hashEntry.depth >= depth {
@@ -180,9 +201,13 @@ func ProbeTranspositionTable(bestMove *uint64, depth int, alpha int, beta int) i
 }
 
 // RecordHash records the hash entry in the transposition table
-func RecordHash(bestMove uint64, depth int, value int, hashFlag int) {
-	hashEntry := &transpositionTable[globals.HashKey%uint64(hashSize)]
-	hashEntry.key = globals.HashKey
+func (s *Searcher) RecordHash(pos *board.Position, bestMove board.Move, depth int, value int, hashFlag int) {
+	key, mirrored := tableKey(pos)
+	if mirrored {
+		bestMove = board.MirrorMove(bestMove)
+	}
+	hashEntry := &s.transpositionTable[key%uint64(len(s.transpositionTable))]
+	hashEntry.key = key
 	hashEntry.depth = depth
 	hashEntry.flags = hashFlag
 	hashEntry.value = value
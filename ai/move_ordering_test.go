@@ -0,0 +1,30 @@
+package ai
+
+import (
+	"testing"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+func TestTranspositionTableSharesMirrorImages(t *testing.T) {
+	for _, fen := range []string{globals.FenDebug2, globals.FenDebug3, globals.FenDebug4} {
+		pos := board.NewPosition()
+		if err := pos.ParseFEN(fen); err != nil {
+			t.Fatal(err)
+		}
+		moveList := board.Moves{}
+		pos.GenerateMoves(&moveList)
+		move := moveList.Moves[0]
+		searcher := NewSearcher(testHashEntries)
+		searcher.RecordHash(pos, move, 4, 123, HashFlagExact)
+		// the entry recorded for the position is found from its mirror image, with the mirrored best move
+		mirrored := pos.Mirrored()
+		bestMove := board.NoMove
+		if value := searcher.ProbeTranspositionTable(mirrored, &bestMove, 4, -WinScore, WinScore); value != 123 {
+			t.Errorf("%q: the mirror image %q probes the value %d, 123 was recorded", fen, mirrored.ToFEN(), value)
+		}
+		if expected := board.MirrorMove(move); bestMove != expected {
+			t.Errorf("%q: the mirror image %q probes the best move %s, expected %s", fen, mirrored.ToFEN(), bestMove, expected)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package ai
+
+import (
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+/*
+	The move picker hands out the moves of a position one at a time, so that moves are only generated and
+	ordered when the search gets to them. When the hash move or a capture produces a beta cutoff, the quiet
+	moves are never generated at all. The moves are picked in stages:
+	1. Hash move, then the PV move when the search is following the principal variation
+	2. Winning captures and promotions, ordered by MVV-LVA
+	3. 1st and 2nd killer moves
+	4. Quiet moves, ordered by the history heuristic
+	5. Losing captures
+	Quiescence search only picks the captures and promotions.
+*/
+
+// Move picker stages
+const (
+	stageHashMove = iota
+	stagePVMove
+	stageGenerateCaptures
+	stageWinningCaptures
+	stageKillers
+	stageGenerateQuiets
+	stageQuiets
+	stageLosingCaptures
+	stageDone
+)
+
+// losingCaptureScore is the score at or above which a capture is a winning capture
+const losingCaptureScore = 10000
+
+// exchangeValues holds the piece values used to find the captures that lose material
+var exchangeValues = [globals.NoPiece]int{100, 300, 350, 500, 900, 400, 100}
+
+// MovePicker yields the moves of a position in the order the search should try them
+type MovePicker struct {
+	searcher     *Searcher
+	pos          *board.Position
+	stage        int
+	capturesOnly bool
+	hashMove     board.Move
+	pvMove       board.Move
+	killer       int
+	captures     board.Moves
+	scores       [len(board.Moves{}.Moves)]int
+	captureIndex int
+	quiets       board.Moves
+	quietIndex   int
+}
+
+// Init prepares the picker to yield all the moves of the position, ordered with the killer moves and history
+// heuristic of the searcher. The hash move and the PV move are tried first if they are pseudo-legal, either can be
+// NoMove
+func (mp *MovePicker) Init(searcher *Searcher, pos *board.Position, hashMove board.Move, pvMove board.Move) {
+	mp.searcher = searcher
+	mp.pos = pos
+	mp.stage = stageHashMove
+	mp.capturesOnly = false
+	mp.hashMove = hashMove
+	mp.pvMove = pvMove
+	mp.killer = 0
+}
+
+// InitCaptures prepares the picker to yield only the captures and promotions of the position
+func (mp *MovePicker) InitCaptures(searcher *Searcher, pos *board.Position) {
+	mp.searcher = searcher
+	mp.pos = pos
+	mp.stage = stageGenerateCaptures
+	mp.capturesOnly = true
+	mp.hashMove = board.NoMove
+	mp.pvMove = board.NoMove
+	mp.killer = 0
+}
+
+// Next returns the next move to search, or NoMove when all the moves have been picked
+func (mp *MovePicker) Next() board.Move {
+	for {
+		switch mp.stage {
+		case stageHashMove:
+			mp.stage = stagePVMove
+			if mp.pos.IsPseudoLegal(mp.hashMove) {
+				return mp.hashMove
+			}
+			mp.hashMove = board.NoMove
+		case stagePVMove:
+			mp.stage = stageGenerateCaptures
+			if mp.pvMove != mp.hashMove && mp.pos.IsPseudoLegal(mp.pvMove) {
+				return mp.pvMove
+			}
+			mp.pvMove = board.NoMove
+		case stageGenerateCaptures:
+			mp.pos.GenerateCaptures(&mp.captures)
+			for i := 0; i < mp.captures.Count; i++ {
+				mp.scores[i] = mp.searcher.scoreCapture(mp.pos, mp.captures.Moves[i])
+			}
+			mp.captureIndex = 0
+			mp.stage = stageWinningCaptures
+		case stageWinningCaptures:
+			if move, score := mp.pickCapture(); move != board.NoMove {
+				if score >= losingCaptureScore {
+					return move
+				}
+				// the best capture left is a losing capture, put it back for the last stage
+				mp.captureIndex--
+			}
+			if mp.capturesOnly {
+				mp.stage = stageLosingCaptures
+			} else {
+				mp.stage = stageKillers
+			}
+		case stageKillers:
+			if mp.killer >= len(mp.searcher.KillerMoves) {
+				mp.stage = stageGenerateQuiets
+				continue
+			}
+			move := mp.searcher.KillerMoves[mp.killer][mp.pos.Ply]
+			mp.killer++
+			// both killer slots can hold the same move when it produced a cutoff twice in a row
+			if mp.killer == 2 && move == mp.searcher.KillerMoves[0][mp.pos.Ply] {
+				continue
+			}
+			if move.IsQuiet() && !mp.isPicked(move) && mp.pos.IsPseudoLegal(move) {
+				return move
+			}
+		case stageGenerateQuiets:
+			mp.pos.GenerateQuietMoves(&mp.quiets)
+			mp.quietIndex = 0
+			mp.stage = stageQuiets
+		case stageQuiets:
+			if move := mp.pickQuiet(); move != board.NoMove {
+				return move
+			}
+			mp.stage = stageLosingCaptures
+		case stageLosingCaptures:
+			if move, _ := mp.pickCapture(); move != board.NoMove {
+				return move
+			}
+			mp.stage = stageDone
+		default:
+			return board.NoMove
+		}
+	}
+}
+
+// pickCapture moves the best scored capture left to the capture index and returns it with its score, the hash and PV
+// moves are skipped as they have already been picked
+func (mp *MovePicker) pickCapture() (board.Move, int) {
+	for mp.captureIndex < mp.captures.Count {
+		current, best := mp.captureIndex, mp.captureIndex
+		for i := current + 1; i < mp.captures.Count; i++ {
+			if mp.scores[i] > mp.scores[best] {
+				best = i
+			}
+		}
+		mp.captures.Moves[current], mp.captures.Moves[best] = mp.captures.Moves[best], mp.captures.Moves[current]
+		mp.scores[current], mp.scores[best] = mp.scores[best], mp.scores[current]
+		mp.captureIndex++
+		if !mp.isPicked(mp.captures.Moves[current]) {
+			return mp.captures.Moves[current], mp.scores[current]
+		}
+	}
+	return board.NoMove, 0
+}
+
+// pickQuiet moves the quiet move with the best history score left to the quiet index and returns it, the hash, PV
+// and killer moves are skipped as they have already been picked
+func (mp *MovePicker) pickQuiet() board.Move {
+	for mp.quietIndex < mp.quiets.Count {
+		current, best := mp.quietIndex, mp.quietIndex
+		for i := current + 1; i < mp.quiets.Count; i++ {
+			if mp.searcher.historyScore(mp.quiets.Moves[i]) > mp.searcher.historyScore(mp.quiets.Moves[best]) {
+				best = i
+			}
+		}
+		mp.quiets.Moves[current], mp.quiets.Moves[best] = mp.quiets.Moves[best], mp.quiets.Moves[current]
+		mp.quietIndex++
+		if move := mp.quiets.Moves[current]; !mp.isPicked(move) && !mp.isKiller(move) {
+			return move
+		}
+	}
+	return board.NoMove
+}
+
+// isPicked returns true if the move is the hash move or the PV move
+func (mp *MovePicker) isPicked(move board.Move) bool {
+	return move == mp.hashMove || move == mp.pvMove
+}
+
+// isKiller returns true if the move is one of the killer moves of the current ply
+func (mp *MovePicker) isKiller(move board.Move) bool {
+	return move == mp.searcher.KillerMoves[0][mp.pos.Ply] || move == mp.searcher.KillerMoves[1][mp.pos.Ply]
+}
+
+// historyScore returns the history heuristic score of a quiet move
+func (s *Searcher) historyScore(move board.Move) uint64 {
+	return s.HistoryHeuristic[move.Piece()][move.Target()]
+}
+
+// scoreCapture scores a capture or promotion, captures that lose material score below losingCaptureScore
+func (s *Searcher) scoreCapture(pos *board.Position, move board.Move) int {
+	score := s.ScoreMove(pos, move)
+	if move.IsCapture() && !move.IsPromotion() && isLosingCapture(pos, move) {
+		score -= losingCaptureScore
+	}
+	return score
+}
+
+// isLosingCapture returns true if the capturing piece is worth more than the captured piece and the opponent can
+// take it back on the target square
+func isLosingCapture(pos *board.Position, move board.Move) bool {
+	if exchangeValues[move.Piece()] <= exchangeValues[move.Captured()] {
+		return false
+	}
+	return pos.IsSquareAttacked(move.Target(), pos.SideToMove^1) == 1
+}
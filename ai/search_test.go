@@ -0,0 +1,95 @@
+package ai
+
+import (
+	"sync"
+	"testing"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+// searchBestMove searches the FEN with a new searcher and returns the best move
+func searchBestMove(t *testing.T, fen string, depth int) board.Move {
+	pos := board.NewPosition()
+	if err := pos.ParseFEN(fen); err != nil {
+		t.Error(err)
+		return board.NoMove
+	}
+	searcher := NewSearcher(testHashEntries)
+	searcher.SearchPosition(pos, depth)
+	return searcher.BestMove
+}
+
+func TestSearchersSearchAtOnce(t *testing.T) {
+	const depth = 5
+	fens := []string{globals.FenDebugStartPosition, globals.FenDebug2}
+	expected := make([]board.Move, len(fens))
+	for i, fen := range fens {
+		expected[i] = searchBestMove(t, fen, depth)
+	}
+	got := make([]board.Move, len(fens))
+	var wg sync.WaitGroup
+	for i, fen := range fens {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got[i] = searchBestMove(t, fen, depth)
+		}()
+	}
+	wg.Wait()
+	for i := range fens {
+		if got[i] != expected[i] {
+			t.Errorf("%q: best move %s searched alongside another position, %s on its own", fens[i], got[i], expected[i])
+		}
+	}
+}
+
+// iterativeDeepening runs the iterative deepening of SearchPosition up to the depth without printing
+func (s *Searcher) iterativeDeepening(pos *board.Position, depth int) {
+	s.clearSearchData(pos)
+	for d := 1; d <= depth; d++ {
+		s.FollowPV = true
+		s.negamax(pos, d, -WinScore, WinScore)
+	}
+}
+
+func TestSearchDoesNotAllocate(t *testing.T) {
+	for _, fen := range []string{globals.FenDebug2, globals.FenDebug3, globals.FenDebug4} {
+		pos := board.NewPosition()
+		if err := pos.ParseFEN(fen); err != nil {
+			t.Fatal(err)
+		}
+		searcher := NewSearcher(testHashEntries)
+		// negamax and quiescence must not allocate, whatever the number of nodes searched
+		if allocations := testing.AllocsPerRun(5, func() { searcher.iterativeDeepening(pos, 6) }); allocations != 0 {
+			t.Errorf("%q: a search to depth 6 made %.0f allocations", fen, allocations)
+		}
+	}
+}
+
+// BenchmarkSearch times an iterative deepening search to depth 7, with a transposition table cleared before every
+// search
+func BenchmarkSearch(b *testing.B) {
+	for _, bench := range []struct {
+		name string
+		fen  string
+	}{
+		{"FenDebug2", globals.FenDebug2},
+		{"FenDebug3", globals.FenDebug3},
+		{"FenDebug4", globals.FenDebug4},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			pos := board.NewPosition()
+			if err := pos.ParseFEN(bench.fen); err != nil {
+				b.Fatal(err)
+			}
+			searcher := NewSearcher(testHashEntries)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				searcher.ClearTranspositionTable()
+				b.StartTimer()
+				searcher.iterativeDeepening(pos, 7)
+			}
+		})
+	}
+}
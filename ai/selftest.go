@@ -0,0 +1,34 @@
+package ai
+
+import (
+	"fmt"
+	"zerginator/board"
+)
+
+// SelfTest runs the checks of the evaluation self test and prints a line for each, it returns an error describing the
+// first check that fails
+func SelfTest() error {
+	return board.RunSelfTestChecks([]board.SelfTestCheck{
+		{Name: "evaluation of mirror images", Check: selfTestMirrorEvaluation},
+	})
+}
+
+// selfTestMirrorEvaluation checks on the self test positions that a position and its left to right mirror image
+// have the same static evaluation
+func selfTestMirrorEvaluation() (string, error) {
+	fens, err := board.SelfTestPositions()
+	if err != nil {
+		return "", err
+	}
+	pos := board.NewPosition()
+	for _, fen := range fens {
+		if err := pos.ParseFEN(fen); err != nil {
+			return "", err
+		}
+		mirrored := pos.Mirrored()
+		if score, mirrorScore := EvaluatePosition(pos), EvaluatePosition(mirrored); score != mirrorScore {
+			return "", fmt.Errorf("%q evaluates to %d, its mirror image %q to %d", fen, score, mirrored.ToFEN(), mirrorScore)
+		}
+	}
+	return fmt.Sprintf("%d positions", len(fens)), nil
+}
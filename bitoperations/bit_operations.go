@@ -1,65 +1,107 @@
 package bitoperations
 
 import (
+	"math/bits"
 	"zerginator/globals"
 )
 
 // GetBit returns the bit at the given square
-func GetBit(bitBoard uint64, square int) uint64 {
+func GetBit(bitBoard globals.Bitboard, square globals.Square) uint64 {
 	/*
 		Here we shift the bitBoard to the right by 1 for a shift count of square index
 		and then use the bitwise AND operator to check if the least significant bit is a 1 or 0.
 		Shifting the bitBoard to the right by 1 is equivalent to dividing the bitBoard by 2 and vice versa.
 	*/
-	return (bitBoard >> square) & 1
+	return uint64(bitBoard>>square) & 1
 }
 
 // SetBit sets the bit at the given square to 1
-func SetBit(bitBoard *uint64, square int) {
+func SetBit(bitBoard *globals.Bitboard, square globals.Square) {
 	*bitBoard |= 1 << square
-	*bitBoard &= globals.Bitboard40Mask
+	*bitBoard &= globals.BoardMask
 }
 
 // PopBit sets the bit at the given square to 0
-func PopBit(bitBoard *uint64, square int) {
-	/*
-		It is important to note that if we use this multiple times, the bit will be flipped
-		multiple times. Therefore, we have to check if the bit is already set to 1 before flipping it
-	*/
-	if GetBit(*bitBoard, square) == 1 {
-		*bitBoard ^= 1 << square
-	} else {
-		return
-	}
+func PopBit(bitBoard *globals.Bitboard, square globals.Square) {
+	// AND NOT clears the bit whether it was set or not, so popping a bit twice is safe
+	*bitBoard &^= 1 << square
 }
 
 // CountBits returns the number of bits set to 1 in the given bitBoard
-func CountBits(bitBoard uint64) int {
-	/*
-		Here we use a trick to count the number of bits set to 1 in a bitBoard.
-		We repeatedly turn off the rightmost bit in the bitBoard and increment a counter
-		until the bitBoard is 0.
-	*/
-	count := 0
-	for bitBoard != 0 {
-		count++
-		// reset the least significant bit
-		bitBoard &= bitBoard - 1
-	}
-	return count
+func CountBits(bitBoard globals.Bitboard) int {
+	// OnesCount64 compiles to the POPCNT instruction where the CPU has it
+	return bits.OnesCount64(uint64(bitBoard))
 }
 
-// GetLeastSignificantBitIndex returns the index of the least significant 1st bit set to 1
-func GetLeastSignificantBitIndex(bitBoard uint64) int {
+// GetLeastSignificantBitIndex returns the index of the least significant 1st bit set to 1, or NoSquare when no bit
+// is set
+func GetLeastSignificantBitIndex(bitBoard globals.Bitboard) globals.Square {
 	/*
-		To get the index of the least significant 1st bit set to 1, we use a trick to isolate the least significant 1-bit
-		and then add trailing ones up to that bit. We then use the CountBits function to get the index.
+		The index of the least significant 1-bit is the number of trailing zeros. TrailingZeros64 compiles to the
+		TZCNT/BSF instruction and returns 64 for an empty bitBoard, which is NoSquare.
 	*/
-	if bitBoard != 0 {
-		// add trailing ones up to the least significant 1-bit
-		bitBoard = (bitBoard & -bitBoard) - 1
-		return CountBits(bitBoard)
-	} else {
-		return -1
-	}
+	return globals.Square(bits.TrailingZeros64(uint64(bitBoard)))
+}
+
+// PopLSB clears the least significant 1st bit of the bitBoard and returns its index. It is the usual way to iterate
+// over the squares of a bitboard:
+//
+//	for bitboard != 0 {
+//		square := bitoperations.PopLSB(&bitboard)
+//		...
+//	}
+func PopLSB(bitBoard *globals.Bitboard) globals.Square {
+	square := GetLeastSignificantBitIndex(*bitBoard)
+	// b & (b - 1) resets the least significant 1-bit
+	*bitBoard &= *bitBoard - 1
+	return square
+}
+
+/*
+	The shift helpers move every square of a bitboard one step in a direction. North is towards the black side
+	(the 8th rank on the default board) and east is towards the last file. Squares are numbered from the top left
+	corner, so north is a right shift by one row and east is a left shift by one square. Squares that would leave
+	the board are dropped: the file masks remove the squares that wrap around to the other side of the board and
+	BoardMask removes the squares shifted past the bottom row.
+*/
+
+// North shifts the bitboard one rank up
+func North(bitBoard globals.Bitboard) globals.Bitboard {
+	return bitBoard >> globals.Geometry.Files
+}
+
+// South shifts the bitboard one rank down
+func South(bitBoard globals.Bitboard) globals.Bitboard {
+	return (bitBoard << globals.Geometry.Files) & globals.BoardMask
+}
+
+// East shifts the bitboard one file to the right
+func East(bitBoard globals.Bitboard) globals.Bitboard {
+	return (bitBoard << 1) & globals.NotAFile
+}
+
+// West shifts the bitboard one file to the left
+func West(bitBoard globals.Bitboard) globals.Bitboard {
+	return (bitBoard >> 1) & globals.NotEFile
+}
+
+// NorthEast shifts the bitboard one rank up and one file to the right
+func NorthEast(bitBoard globals.Bitboard) globals.Bitboard {
+	return (bitBoard >> (globals.Geometry.Files - 1)) & globals.NotAFile
+}
+
+// NorthWest shifts the bitboard one rank up and one file to the left
+func NorthWest(bitBoard globals.Bitboard) globals.Bitboard {
+	return (bitBoard >> (globals.Geometry.Files + 1)) & globals.NotEFile
+}
+
+// SouthEast shifts the bitboard one rank down and one file to the right
+func SouthEast(bitBoard globals.Bitboard) globals.Bitboard {
+	// NotAFile only holds squares of the board, so it also drops the squares shifted past the bottom row
+	return (bitBoard << (globals.Geometry.Files + 1)) & globals.NotAFile
+}
+
+// SouthWest shifts the bitboard one rank down and one file to the left
+func SouthWest(bitBoard globals.Bitboard) globals.Bitboard {
+	return (bitBoard << (globals.Geometry.Files - 1)) & globals.NotEFile
 }
@@ -1,106 +1,49 @@
 package board
 
 import (
+	"fmt"
 	"zerginator/bitoperations"
 	"zerginator/globals"
 )
 
 // MaskPawnAttacks returns the bitboard of all the pawn attacks on the given square
-func MaskPawnAttacks(side int, square int) uint64 {
-	// results/attacks bitboard
-	var attacks uint64 = 0
+func MaskPawnAttacks(side globals.Color, square globals.Square) globals.Bitboard {
 	// piece bitboard
-	var bitboard uint64 = 0
-
-	// set piece on board
-	bitoperations.SetBit(&bitboard, square)
+	bitboard := square.Bit()
 
+	// the shift helpers drop the attacks that would leave the board
 	if side == globals.WHITE {
-		// handle off-board captures and generate attacks
-		if (bitboard>>4)&globals.NotAFile != 0 {
-			attacks |= bitboard >> 4
-		}
-		if (bitboard>>6)&globals.NotEFile != 0 {
-			attacks |= bitboard >> 6
-		}
-	} else {
-		if (bitboard<<4)&globals.NotEFile != 0 {
-			attacks |= bitboard << 4
-		}
-		if (bitboard<<6)&globals.NotAFile != 0 {
-			attacks |= bitboard << 6
-		}
+		return bitoperations.NorthEast(bitboard) | bitoperations.NorthWest(bitboard)
 	}
-	return attacks & globals.Bitboard40Mask
+	return bitoperations.SouthEast(bitboard) | bitoperations.SouthWest(bitboard)
 }
 
 // MaskKnightAttacks returns the bitboard of all the knight attacks on the given square
-func MaskKnightAttacks(square int) uint64 {
-	var attacks uint64 = 0
-	var bitboard uint64 = 0
-	bitoperations.SetBit(&bitboard, square)
-	if (bitboard>>11)&globals.NotEFile != 0 {
-		attacks |= bitboard >> 11
-	}
-	if (bitboard>>9)&globals.NotAFile != 0 {
-		attacks |= bitboard >> 9
-	}
-	if (bitboard>>7)&globals.NotDEFile != 0 {
-		attacks |= bitboard >> 7
-	}
-	if (bitboard>>3)&globals.NotABFile != 0 {
-		attacks |= bitboard >> 3
-	}
-	if (bitboard<<11)&globals.NotAFile != 0 {
-		attacks |= bitboard << 11
-	}
-	if (bitboard<<9)&globals.NotEFile != 0 {
-		attacks |= bitboard << 9
-	}
-	if (bitboard<<7)&globals.NotABFile != 0 {
-		attacks |= bitboard << 7
-	}
-	if (bitboard<<3)&globals.NotDEFile != 0 {
-		attacks |= bitboard << 3
-	}
-	return attacks & globals.Bitboard40Mask
+func MaskKnightAttacks(square globals.Square) globals.Bitboard {
+	bitboard := square.Bit()
+	/*
+		Each knight jump is a diagonal step followed by a straight step away from the start square. Every step
+		drops the squares that leave the board, so a jump that would cross the edge of the board is lost.
+	*/
+	northEast, northWest := bitoperations.NorthEast(bitboard), bitoperations.NorthWest(bitboard)
+	southEast, southWest := bitoperations.SouthEast(bitboard), bitoperations.SouthWest(bitboard)
+	return bitoperations.North(northEast|northWest) | bitoperations.South(southEast|southWest) |
+		bitoperations.East(northEast|southEast) | bitoperations.West(northWest|southWest)
 }
 
 // MaskKingAttacks returns the bitboard of all the king attacks on the given square
-func MaskKingAttacks(square int) uint64 {
-	var attacks uint64 = 0
-	var bitboard uint64 = 0
-	bitoperations.SetBit(&bitboard, square)
-	if (bitboard>>1)&globals.NotEFile != 0 {
-		attacks |= bitboard >> 1
-	}
-	if (bitboard>>4)&globals.NotAFile != 0 {
-		attacks |= bitboard >> 4
-	}
-	if (bitboard >> 5) != 0 {
-		attacks |= bitboard >> 5
-	}
-	if (bitboard>>6)&globals.NotEFile != 0 {
-		attacks |= bitboard >> 6
-	}
-	if (bitboard<<1)&globals.NotAFile != 0 {
-		attacks |= bitboard << 1
-	}
-	if (bitboard<<4)&globals.NotEFile != 0 {
-		attacks |= bitboard << 4
-	}
-	if (bitboard << 5) != 0 {
-		attacks |= bitboard << 5
-	}
-	if (bitboard<<6)&globals.NotAFile != 0 {
-		attacks |= bitboard << 6
-	}
-	return attacks & globals.Bitboard40Mask
+func MaskKingAttacks(square globals.Square) globals.Bitboard {
+	bitboard := square.Bit()
+	// the king steps one square in each of the eight directions
+	attacks := bitoperations.East(bitboard) | bitoperations.West(bitboard)
+	// the diagonal steps are the straight steps of the king on the squares beside it
+	besides := bitboard | attacks
+	return attacks | bitoperations.North(besides) | bitoperations.South(besides)
 }
 
 // InitLeapersAttacks initializes the pawn, king, and knight attacks tables
 func InitLeapersAttacks() {
-	for square := 0; square < 40; square++ {
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
 		globals.PawnAttacks[globals.WHITE][square] = MaskPawnAttacks(globals.WHITE, square)
 		globals.PawnAttacks[globals.BLACK][square] = MaskPawnAttacks(globals.BLACK, square)
 		globals.KnightAttacks[square] = MaskKnightAttacks(square)
@@ -109,112 +52,116 @@ func InitLeapersAttacks() {
 }
 
 // MaskBishopAttacks returns the bitboard of all the bishop attacks on the given square
-func MaskBishopAttacks(square int) uint64 {
-	var attacks uint64 = 0
-	var targetRank, targetFile = square / 5, square % 5
+func MaskBishopAttacks(square globals.Square) globals.Bitboard {
+	var attacks globals.Bitboard = 0
+	var targetRank, targetFile = globals.Geometry.Rank(square), globals.Geometry.File(square)
+	lastRank, lastFile := globals.Geometry.Ranks-1, globals.Geometry.Files-1
 	// mask the relevant bishop occupancy bits
-	for r, f := targetRank+1, targetFile+1; r <= 6 && f <= 3; r, f = r+1, f+1 {
-		attacks |= 1 << (r*5 + f)
+	for r, f := targetRank+1, targetFile+1; r <= lastRank-1 && f <= lastFile-1; r, f = r+1, f+1 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
 	}
-	for r, f := targetRank-1, targetFile+1; r >= 1 && f <= 3; r, f = r-1, f+1 {
-		attacks |= 1 << (r*5 + f)
+	for r, f := targetRank-1, targetFile+1; r >= 1 && f <= lastFile-1; r, f = r-1, f+1 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
 	}
-	for r, f := targetRank+1, targetFile-1; r <= 6 && f >= 1; r, f = r+1, f-1 {
-		attacks |= 1 << (r*5 + f)
+	for r, f := targetRank+1, targetFile-1; r <= lastRank-1 && f >= 1; r, f = r+1, f-1 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
 	}
 	for r, f := targetRank-1, targetFile-1; r >= 1 && f >= 1; r, f = r-1, f-1 {
-		attacks |= 1 << (r*5 + f)
+		attacks |= 1 << globals.Geometry.Square(r, f)
 	}
-	return attacks & globals.Bitboard40Mask
+	return attacks & globals.BoardMask
 }
 
 // MaskRookAttacks returns the bitboard of all the rook attacks on the given square
-func MaskRookAttacks(square int) uint64 {
-	var attacks uint64 = 0
-	var targetRank, targetFile = square / 5, square % 5
+func MaskRookAttacks(square globals.Square) globals.Bitboard {
+	var attacks globals.Bitboard = 0
+	var targetRank, targetFile = globals.Geometry.Rank(square), globals.Geometry.File(square)
+	lastRank, lastFile := globals.Geometry.Ranks-1, globals.Geometry.Files-1
 	// mask the relevant rook occupancy bits
-	for r := targetRank + 1; r <= 6; r++ {
-		attacks |= 1 << (r*5 + targetFile)
+	for r := targetRank + 1; r <= lastRank-1; r++ {
+		attacks |= 1 << globals.Geometry.Square(r, targetFile)
 	}
 	for r := targetRank - 1; r >= 1; r-- {
-		attacks |= 1 << (r*5 + targetFile)
+		attacks |= 1 << globals.Geometry.Square(r, targetFile)
 	}
-	for f := targetFile + 1; f <= 3; f++ {
-		attacks |= 1 << (targetRank*5 + f)
+	for f := targetFile + 1; f <= lastFile-1; f++ {
+		attacks |= 1 << globals.Geometry.Square(targetRank, f)
 	}
 	for f := targetFile - 1; f >= 1; f-- {
-		attacks |= 1 << (targetRank*5 + f)
+		attacks |= 1 << globals.Geometry.Square(targetRank, f)
 	}
-	return attacks & globals.Bitboard40Mask
+	return attacks & globals.BoardMask
 }
 
 // BishopAttacksOnTheFly generates bishop attacks taking into account a piece block
-func BishopAttacksOnTheFly(square int, block uint64) uint64 {
-	var attacks uint64 = 0
-	var targetRank, targetFile = square / 5, square % 5
+func BishopAttacksOnTheFly(square globals.Square, block globals.Bitboard) globals.Bitboard {
+	var attacks globals.Bitboard = 0
+	var targetRank, targetFile = globals.Geometry.Rank(square), globals.Geometry.File(square)
+	lastRank, lastFile := globals.Geometry.Ranks-1, globals.Geometry.Files-1
 	// generate bishop attacks
-	for r, f := targetRank+1, targetFile+1; r <= 7 && f <= 4; r, f = r+1, f+1 {
-		attacks |= 1 << (r*5 + f)
-		if (1<<(r*5+f))&block != 0 {
+	for r, f := targetRank+1, targetFile+1; r <= lastRank && f <= lastFile; r, f = r+1, f+1 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
+		if (1<<globals.Geometry.Square(r, f))&block != 0 {
 			break
 		}
 	}
-	for r, f := targetRank-1, targetFile+1; r >= 0 && f <= 4; r, f = r-1, f+1 {
-		attacks |= 1 << (r*5 + f)
-		if (1<<(r*5+f))&block != 0 {
+	for r, f := targetRank-1, targetFile+1; r >= 0 && f <= lastFile; r, f = r-1, f+1 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
+		if (1<<globals.Geometry.Square(r, f))&block != 0 {
 			break
 		}
 	}
-	for r, f := targetRank+1, targetFile-1; r <= 7 && f >= 0; r, f = r+1, f-1 {
-		attacks |= 1 << (r*5 + f)
-		if (1<<(r*5+f))&block != 0 {
+	for r, f := targetRank+1, targetFile-1; r <= lastRank && f >= 0; r, f = r+1, f-1 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
+		if (1<<globals.Geometry.Square(r, f))&block != 0 {
 			break
 		}
 	}
 	for r, f := targetRank-1, targetFile-1; r >= 0 && f >= 0; r, f = r-1, f-1 {
-		attacks |= 1 << (r*5 + f)
-		if (1<<(r*5+f))&block != 0 {
+		attacks |= 1 << globals.Geometry.Square(r, f)
+		if (1<<globals.Geometry.Square(r, f))&block != 0 {
 			break
 		}
 	}
-	return attacks & globals.Bitboard40Mask
+	return attacks & globals.BoardMask
 }
 
 // RookAttacksOnTheFly generates rook attacks taking into account a piece block
-func RookAttacksOnTheFly(square int, block uint64) uint64 {
-	var attacks uint64 = 0
-	var targetRank, targetFile = square / 5, square % 5
+func RookAttacksOnTheFly(square globals.Square, block globals.Bitboard) globals.Bitboard {
+	var attacks globals.Bitboard = 0
+	var targetRank, targetFile = globals.Geometry.Rank(square), globals.Geometry.File(square)
+	lastRank, lastFile := globals.Geometry.Ranks-1, globals.Geometry.Files-1
 	// mask the relevant rook occupancy bits
-	for r := targetRank + 1; r <= 7; r++ {
-		attacks |= 1 << (r*5 + targetFile)
-		if (1<<(r*5+targetFile))&block != 0 {
+	for r := targetRank + 1; r <= lastRank; r++ {
+		attacks |= 1 << globals.Geometry.Square(r, targetFile)
+		if (1<<globals.Geometry.Square(r, targetFile))&block != 0 {
 			break
 		}
 	}
 	for r := targetRank - 1; r >= 0; r-- {
-		attacks |= 1 << (r*5 + targetFile)
-		if (1<<(r*5+targetFile))&block != 0 {
+		attacks |= 1 << globals.Geometry.Square(r, targetFile)
+		if (1<<globals.Geometry.Square(r, targetFile))&block != 0 {
 			break
 		}
 	}
-	for f := targetFile + 1; f <= 4; f++ {
-		attacks |= 1 << (targetRank*5 + f)
-		if (1<<(targetRank*5+f))&block != 0 {
+	for f := targetFile + 1; f <= lastFile; f++ {
+		attacks |= 1 << globals.Geometry.Square(targetRank, f)
+		if (1<<globals.Geometry.Square(targetRank, f))&block != 0 {
 			break
 		}
 	}
 	for f := targetFile - 1; f >= 0; f-- {
-		attacks |= 1 << (targetRank*5 + f)
-		if (1<<(targetRank*5+f))&block != 0 {
+		attacks |= 1 << globals.Geometry.Square(targetRank, f)
+		if (1<<globals.Geometry.Square(targetRank, f))&block != 0 {
 			break
 		}
 	}
 
-	return attacks & globals.Bitboard40Mask
+	return attacks & globals.BoardMask
 }
 
 // SetOccupancy sets the occupancy of a square on the board and returns the occupancy
-func SetOccupancy(index int, maskBitCount int, attackMask uint64) uint64 {
+func SetOccupancy(index int, maskBitCount int, attackMask globals.Bitboard) globals.Bitboard {
 	/*
 		This function creates the occupancy bitboard for a given attack pattern. The index parameter serves
 		as a mask to determine which squares of the attack pattern are occupied. For example, if the attack
@@ -222,61 +169,107 @@ func SetOccupancy(index int, maskBitCount int, attackMask uint64) uint64 {
 		pattern is considered blocked. Likewise, if index=0b0101 that means that the first and third squares are
 		blocked.
 	*/
-	var occupancy uint64
+	var occupancy globals.Bitboard
 	for i := 0; i < maskBitCount; i++ {
-		square := bitoperations.GetLeastSignificantBitIndex(attackMask)
 		// pop the least significant bit in the attack_mask
-		bitoperations.PopBit(&attackMask, square)
+		square := bitoperations.PopLSB(&attackMask)
 		if (index & (1 << i)) != 0 {
 			occupancy |= 1 << square
 		}
 	}
-	return occupancy & globals.Bitboard40Mask
+	return occupancy & globals.BoardMask
 }
 
 // InitSlidersAttacks initializes the sliders attacks tables
 func InitSlidersAttacks(isBishop int) {
-	// init bishop and rook attacks
-	for square := 0; square < 40; square++ {
+	// size the table first, each square takes 2^relevant-bits entries starting at its offset
+	tableSize := 0
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
 		globals.BishopMasks[square] = MaskBishopAttacks(square)
 		globals.RookMasks[square] = MaskRookAttacks(square)
-		var attackMask uint64
 		if isBishop == globals.BISHOP {
-			attackMask = globals.BishopMasks[square]
-
+			relevantBitCount := bitoperations.CountBits(globals.BishopMasks[square])
+			globals.BishopRelevantOccupancyCount[square] = relevantBitCount
+			globals.BishopShifts[square] = uint(64 - relevantBitCount)
+			globals.BishopAttackOffsets[square] = tableSize
+			tableSize += 1 << relevantBitCount
 		} else {
-			attackMask = globals.RookMasks[square]
+			relevantBitCount := bitoperations.CountBits(globals.RookMasks[square])
+			globals.RookRelevantOccupancyCount[square] = relevantBitCount
+			globals.RookShifts[square] = uint(64 - relevantBitCount)
+			globals.RookAttackOffsets[square] = tableSize
+			tableSize += 1 << relevantBitCount
 		}
-		relevantBitCount := bitoperations.CountBits(attackMask)
-		occupancyIndices := 1 << relevantBitCount
-		for index := 0; index < occupancyIndices; index++ {
-			if isBishop == globals.BISHOP {
-				occupancy := SetOccupancy(index, relevantBitCount, attackMask)
-				magicIndex := (occupancy * globals.BishopMagicNumbers[square]) >> (64 - globals.BishopRelevantOccupancyCount[square])
-				globals.BishopAttacks[square][magicIndex] = BishopAttacksOnTheFly(square, occupancy)
-			} else {
-				occupancy := SetOccupancy(index, relevantBitCount, attackMask)
-				magicIndex := (occupancy * globals.RookMagicNumbers[square]) >> (64 - globals.RookRelevantOccupancyCount[square])
-				globals.RookAttacks[square][magicIndex] = RookAttacksOnTheFly(square, occupancy)
+	}
+	if isBishop == globals.BISHOP {
+		globals.BishopAttacks = make([]globals.Bitboard, tableSize)
+	} else {
+		globals.RookAttacks = make([]globals.Bitboard, tableSize)
+	}
+
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
+		if isBishop == globals.BISHOP {
+			attackMask := globals.BishopMasks[square]
+			for index := 0; index < 1<<globals.BishopRelevantOccupancyCount[square]; index++ {
+				occupancy := SetOccupancy(index, globals.BishopRelevantOccupancyCount[square], attackMask)
+				globals.BishopAttacks[bishopAttackIndex(square, occupancy)] = BishopAttacksOnTheFly(square, occupancy)
+			}
+		} else {
+			attackMask := globals.RookMasks[square]
+			for index := 0; index < 1<<globals.RookRelevantOccupancyCount[square]; index++ {
+				occupancy := SetOccupancy(index, globals.RookRelevantOccupancyCount[square], attackMask)
+				globals.RookAttacks[rookAttackIndex(square, occupancy)] = RookAttacksOnTheFly(square, occupancy)
 			}
 		}
 	}
 }
 
+// bishopAttackIndex returns the index in BishopAttacks of the bishop attacks for the given square and occupancy
+func bishopAttackIndex(square globals.Square, occupancy globals.Bitboard) int {
+	magicIndex := uint64(occupancy&globals.BishopMasks[square]) * globals.BishopMagicNumbers[square]
+	return globals.BishopAttackOffsets[square] + int(magicIndex>>globals.BishopShifts[square])
+}
+
+// rookAttackIndex returns the index in RookAttacks of the rook attacks for the given square and occupancy
+func rookAttackIndex(square globals.Square, occupancy globals.Bitboard) int {
+	magicIndex := uint64(occupancy&globals.RookMasks[square]) * globals.RookMagicNumbers[square]
+	return globals.RookAttackOffsets[square] + int(magicIndex>>globals.RookShifts[square])
+}
+
 // GetBishopAttacks returns the bitboard of all the bishop attacks on the given square
-func GetBishopAttacks(square int, occupancy uint64) uint64 {
+func GetBishopAttacks(square globals.Square, occupancy globals.Bitboard) globals.Bitboard {
 	// Here we get the bishop attacks for the current board occupancies
-	occupancy &= globals.BishopMasks[square]
-	occupancy *= globals.BishopMagicNumbers[square]
-	occupancy >>= 64 - globals.BishopRelevantOccupancyCount[square]
-	return globals.BishopAttacks[square][occupancy]
+	return globals.BishopAttacks[bishopAttackIndex(square, occupancy)]
+}
+
+// GetQueenAttacks returns the bitboard of all the queen attacks on the given square, the union of the bishop and rook
+// attacks
+func GetQueenAttacks(square globals.Square, occupancy globals.Bitboard) globals.Bitboard {
+	return GetBishopAttacks(square, occupancy) | GetRookAttacks(square, occupancy)
 }
 
 // GetRookAttacks returns the bitboard of all the rook attacks on the given square
-func GetRookAttacks(square int, occupancy uint64) uint64 {
-	// Here we get the bishop attacks for the current board occupancies
-	occupancy &= globals.RookMasks[square]
-	occupancy *= globals.RookMagicNumbers[square]
-	occupancy >>= 64 - globals.RookRelevantOccupancyCount[square]
-	return globals.RookAttacks[square][occupancy]
+func GetRookAttacks(square globals.Square, occupancy globals.Bitboard) globals.Bitboard {
+	// Here we get the rook attacks for the current board occupancies
+	return globals.RookAttacks[rookAttackIndex(square, occupancy)]
+}
+
+// CheckSliderAttacks compares the attack tables with the attacks generated on the fly for every subset of the
+// relevant occupancy of every square, and returns an error describing the first mismatch
+func CheckSliderAttacks() error {
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
+		for index := 0; index < 1<<globals.BishopRelevantOccupancyCount[square]; index++ {
+			occupancy := SetOccupancy(index, globals.BishopRelevantOccupancyCount[square], globals.BishopMasks[square])
+			if got, want := GetBishopAttacks(square, occupancy), BishopAttacksOnTheFly(square, occupancy); got != want {
+				return fmt.Errorf("bishop on %s with occupancy %s: table gives %s, expected %s", square, occupancy, got, want)
+			}
+		}
+		for index := 0; index < 1<<globals.RookRelevantOccupancyCount[square]; index++ {
+			occupancy := SetOccupancy(index, globals.RookRelevantOccupancyCount[square], globals.RookMasks[square])
+			if got, want := GetRookAttacks(square, occupancy), RookAttacksOnTheFly(square, occupancy); got != want {
+				return fmt.Errorf("rook on %s with occupancy %s: table gives %s, expected %s", square, occupancy, got, want)
+			}
+		}
+	}
+	return nil
 }
@@ -0,0 +1,92 @@
+package board
+
+import (
+	"testing"
+	"zerginator/globals"
+)
+
+// sliderAttacksSink keeps the benchmarked lookups from being optimised away
+var sliderAttacksSink globals.Bitboard
+
+// squareSet returns the bitboard of the named squares
+func squareSet(t *testing.T, names ...string) globals.Bitboard {
+	var set globals.Bitboard
+	for _, name := range names {
+		square, err := globals.ParseSquare(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		set |= square.Bit()
+	}
+	return set
+}
+
+func TestSliderAttacks(t *testing.T) {
+	for _, test := range []struct {
+		piece     string
+		square    string
+		occupancy []string
+		attacks   []string
+	}{
+		{"rook", "a1", nil, []string{"a2", "a3", "a4", "a5", "a6", "a7", "a8", "b1", "c1", "d1", "e1"}},
+		{"rook", "c4", []string{"c6", "e4", "b1"}, []string{"c5", "c6", "c3", "c2", "c1", "b4", "a4", "d4", "e4"}},
+		{"bishop", "c3", nil, []string{"b2", "a1", "d4", "e5", "b4", "a5", "d2", "e1"}},
+		{"bishop", "a8", []string{"c6", "a7"}, []string{"b7", "c6"}},
+	} {
+		square, err := globals.ParseSquare(test.square)
+		if err != nil {
+			t.Fatal(err)
+		}
+		occupancy, expected := squareSet(t, test.occupancy...), squareSet(t, test.attacks...)
+		attacks := GetRookAttacks(square, occupancy)
+		if test.piece == "bishop" {
+			attacks = GetBishopAttacks(square, occupancy)
+		}
+		if attacks != expected {
+			t.Errorf("%s on %s with occupancy %s attacks %s, expected %s", test.piece, square, occupancy, attacks, expected)
+		}
+	}
+}
+
+func TestSliderAttacksOfEveryOccupancy(t *testing.T) {
+	// every subset of the relevant occupancy of every square must give the attacks generated on the fly
+	for _, slider := range []struct {
+		piece     string
+		masks     *[globals.MaxSquares]globals.Bitboard
+		bitCounts *[globals.MaxSquares]int
+		attacks   func(globals.Square, globals.Bitboard) globals.Bitboard
+		onTheFly  func(globals.Square, globals.Bitboard) globals.Bitboard
+	}{
+		{"bishop", &globals.BishopMasks, &globals.BishopRelevantOccupancyCount, GetBishopAttacks, BishopAttacksOnTheFly},
+		{"rook", &globals.RookMasks, &globals.RookRelevantOccupancyCount, GetRookAttacks, RookAttacksOnTheFly},
+	} {
+		for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
+			for index := 0; index < 1<<slider.bitCounts[square]; index++ {
+				occupancy := SetOccupancy(index, slider.bitCounts[square], slider.masks[square])
+				if got, want := slider.attacks(square, occupancy), slider.onTheFly(square, occupancy); got != want {
+					// one failure per square is enough to find the broken table entry
+					t.Errorf("%s on %s with occupancy %s: table gives %s, expected %s", slider.piece, square, occupancy, got, want)
+					break
+				}
+			}
+		}
+	}
+}
+
+// BenchmarkSliderAttacks times a GetBishopAttacks and a GetRookAttacks lookup per iteration on sparse random
+// occupancies, which are closer to real positions than uniform random bits
+func BenchmarkSliderAttacks(b *testing.B) {
+	var occupancies [1024]globals.Bitboard
+	for i := range occupancies {
+		occupancies[i] = globals.Bitboard(GetRandomUInt64()&GetRandomUInt64()) & globals.BoardMask
+	}
+	squares := int(globals.Geometry.Squares())
+	var attacks globals.Bitboard
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		square := globals.Square(i % squares)
+		occupancy := occupancies[(i/squares)%len(occupancies)]
+		attacks += GetBishopAttacks(square, occupancy) + GetRookAttacks(square, occupancy)
+	}
+	sliderAttacksSink = attacks
+}
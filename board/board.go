@@ -2,177 +2,320 @@ package board
 
 import (
 	"fmt"
-	"math/rand"
+	"io"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
 	"zerginator/bitoperations"
 	"zerginator/globals"
 )
 
+// Position holds the complete state of a game: the piece placement, the side to move, the en-passant square and
+// the bookkeeping needed to undo moves and to detect repetitions
+type Position struct {
+	// Bitboards holds the bitboards for each piece: black has only pawns, white has pawns, knight, bishop, rook, queen
+	// and king
+	Bitboards [globals.NoPiece]globals.Bitboard
+	// Occupancies hold the occupancy of each square
+	Occupancies [3]globals.Bitboard
+	// Mailbox holds the piece on each square, NoPiece for empty squares, and is kept in sync with the bitboards
+	Mailbox [globals.MaxSquares]globals.Piece
+	// SideToMove holds the side to move
+	SideToMove globals.Color
+	// EnPassantSquare holds the square of the en passant target
+	EnPassantSquare globals.Square
+	// HashKey holds the hash key for the current position
+	HashKey uint64
+	// Ply is the current ply in the search tree
+	Ply int
+	// MoveStack is the game history: the move records of the moves made since the position was set up, including the
+	// moves made during a search, indexed by the number of moves made. It is used to undo moves and to detect
+	// repetitions, the hash key of each record is the key of the position before its move
+	MoveStack []MoveRecord
+	// MoveCount holds the number of moves made since the position was set up
+	MoveCount int
+	// IrreversibleIndex holds the number of moves made when the last irreversible move was played, the positions
+	// before it cannot occur again
+	IrreversibleIndex int
+	// HalfmoveClock holds the number of moves in a row without a pawn move or capture, for the no-progress rule
+	HalfmoveClock int
+	// FullmoveNumber holds the number of the current move, it goes up after every black move. It is 0 when the FEN the
+	// position was set up from had no fullmove number, and then it is not counted
+	FullmoveNumber int
+}
+
+// NewPosition returns an empty position with white to move
+func NewPosition() *Position {
+	pos := &Position{SideToMove: globals.WHITE, EnPassantSquare: globals.NoSquare}
+	for square := range pos.Mailbox {
+		pos.Mailbox[square] = globals.NoPiece
+	}
+	return pos
+}
+
+// PieceAt returns the piece on the given square, or NoPiece if the square is empty
+func (pos *Position) PieceAt(square globals.Square) globals.Piece {
+	return pos.Mailbox[square]
+}
+
+// PutPiece puts the piece on the empty square, keeping the occupancies, the mailbox and the hash key in sync. It sets
+// up positions, moves are played with MakeMove
+func (pos *Position) PutPiece(piece globals.Piece, square globals.Square) {
+	bitoperations.SetBit(&pos.Bitboards[piece], square)
+	pos.Occupancies[piece.Color()] |= square.Bit()
+	pos.Occupancies[globals.BOTH] |= square.Bit()
+	pos.Mailbox[square] = piece
+	pos.HashKey ^= PieceKeys[piece][square]
+}
+
+/*
+	The diagrams and move lists below are written to an io.Writer. The Print functions write them to the console and
+	the String functions return them, so the UCI loop can send them as info strings instead of mixing them into the
+	protocol stream.
+*/
+
 // PrintBitBoard prints the bitboard to the console
-func PrintBitBoard(bitBoard uint64) {
+func PrintBitBoard(bitBoard globals.Bitboard) {
+	WriteBitBoard(os.Stdout, bitBoard)
+}
+
+// BitBoardString returns the diagram of the bitboard PrintBitBoard prints
+func BitBoardString(bitBoard globals.Bitboard) string {
+	var diagram strings.Builder
+	WriteBitBoard(&diagram, bitBoard)
+	return diagram.String()
+}
+
+// WriteBitBoard writes the diagram of the bitboard to w
+func WriteBitBoard(w io.Writer, bitBoard globals.Bitboard) {
 	// To access this function in main.go, it must be capitalised as only these are exported
-	fmt.Println()
-	fmt.Println()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w)
 	// loop over the board ranks
-	for rank := 0; rank < 8; rank++ {
+	for rank := 0; rank < globals.Geometry.Ranks; rank++ {
 		// loop over the board files
-		for file := 0; file < 5; file++ {
-			square := rank*5 + file
+		for file := 0; file < globals.Geometry.Files; file++ {
+			square := globals.Geometry.Square(rank, file)
 			if file == 0 {
-				fmt.Printf("\t%d ", 8-rank)
+				fmt.Fprintf(w, "\t%d ", globals.Geometry.Ranks-rank)
 			}
-			fmt.Printf("  %d", bitoperations.GetBit(bitBoard, square))
+			fmt.Fprintf(w, "  %d", bitoperations.GetBit(bitBoard, square))
 		}
-		fmt.Println()
+		fmt.Fprintln(w)
 	}
-	fmt.Printf("\t    A  B  C  D  E\n")
-	fmt.Println("\tBitboard: ", bitBoard)
-	fmt.Println()
+	fmt.Fprintf(w, "\t    %s\n", fileLetters())
+	fmt.Fprintln(w, "\tBitboard: ", bitBoard)
+	fmt.Fprintln(w)
 }
 
 // PrintBoard combines the bitboards of the pieces and prints them to the console
-func PrintBoard() {
-	fmt.Println()
-	fmt.Println()
-	for rank := 0; rank < 8; rank++ {
-		for file := 0; file < 5; file++ {
-			square := rank*5 + file
+func (pos *Position) PrintBoard() {
+	pos.WriteBoard(os.Stdout)
+}
+
+// BoardString returns the diagram of the position PrintBoard prints
+func (pos *Position) BoardString() string {
+	var diagram strings.Builder
+	pos.WriteBoard(&diagram)
+	return diagram.String()
+}
+
+// WriteBoard writes the diagram of the position to w, with the side to move, the en passant square and the hash key
+func (pos *Position) WriteBoard(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w)
+	for rank := 0; rank < globals.Geometry.Ranks; rank++ {
+		for file := 0; file < globals.Geometry.Files; file++ {
+			square := globals.Geometry.Square(rank, file)
 			if file == 0 {
-				fmt.Printf("\t%d ", 8-rank)
-			}
-			piece := -1
-			for bbPiece := 0; bbPiece <= globals.BlackPawn; bbPiece++ {
-				if bitoperations.GetBit(globals.Bitboards[bbPiece], square) == 1 {
-					piece = bbPiece
-				}
+				fmt.Fprintf(w, "\t%d ", globals.Geometry.Ranks-rank)
 			}
-			if piece == -1 {
-				fmt.Printf("  .")
+			piece := pos.PieceAt(square)
+			if piece == globals.NoPiece {
+				fmt.Fprintf(w, "  .")
 			} else {
-				fmt.Printf("  %s", globals.UnicodePieces[piece])
-				//fmt.Printf(" %s", AsciiPieces[piece])
+				fmt.Fprintf(w, "  %s", globals.UnicodePieces[piece])
+				//fmt.Fprintf(w, " %s", AsciiPieces[piece])
 			}
 		}
-		fmt.Println()
+		fmt.Fprintln(w)
 	}
-	fmt.Printf("\t    A  B  C  D  E\n\n")
-	if globals.SideToMove == globals.WHITE {
-		fmt.Println("\tSide to move: White")
+	fmt.Fprintf(w, "\t    %s\n\n", fileLetters())
+	if pos.SideToMove == globals.WHITE {
+		fmt.Fprintln(w, "\tSide to move: White")
 	} else {
-		fmt.Println("\tSide to move: Black")
+		fmt.Fprintln(w, "\tSide to move: Black")
 	}
-	if globals.EnPassantSquare != globals.NoSquare {
-		fmt.Println("\tEn-passant square:", globals.SquareToCoord[globals.EnPassantSquare])
+	if pos.EnPassantSquare != globals.NoSquare {
+		fmt.Fprintln(w, "\tEn-passant square:", pos.EnPassantSquare)
 	} else {
-		fmt.Println("\tEn-passant square: None")
+		fmt.Fprintln(w, "\tEn-passant square: None")
 	}
-	fmt.Printf("\tHash key: %x\n", globals.HashKey)
-	//fmt.Println()
+	fmt.Fprintf(w, "\tHash key: %x\n", pos.HashKey)
+	//fmt.Fprintln(w)
 }
 
-// ParseFEN parses the FEN string and populates the bitboards and state variables
-func ParseFEN(fen string) {
-	/*
-		This parses a custom FEN string and populates the bitboards and state variables.
-		The FEN string only contains the piece placement data, the side to move and en-passant square.
-		For example, a FEN string where only a white pawn is on a1 would be "5/5/5/5/5/5/5/P4 - -"
-	*/
-	// reset board
-	for i := 0; i <= globals.BlackPawn; i++ {
-		globals.Bitboards[i] = 0
+// StartPositionFEN returns the FEN of the start position with the bottom row arrangement of the given index in
+// FenStartWhiteBottomRow, from 0 to 119
+func StartPositionFEN(index int) (string, error) {
+	if index < 0 || index >= len(globals.FenStartWhiteBottomRow) {
+		return "", fmt.Errorf("start arrangement %d is not between 0 and %d", index, len(globals.FenStartWhiteBottomRow)-1)
 	}
-	for i := 0; i < len(globals.Occupancies); i++ {
-		globals.Occupancies[i] = 0
+	return StartPosFEN(globals.FenStartWhiteBottomRow[index]), nil
+}
+
+// ParseStartArrangement returns the index of the start arrangement given by its index, such as "37", or by its
+// bottom row, such as "RNK1B"
+func ParseStartArrangement(s string) (int, error) {
+	if index, err := strconv.Atoi(s); err == nil {
+		if _, err := StartPositionFEN(index); err != nil {
+			return 0, err
+		}
+		return index, nil
 	}
-	globals.SideToMove = globals.WHITE
-	globals.EnPassantSquare = globals.NoSquare
-	globals.RepetitionIndex = 0
-	for i := 0; i < len(globals.RepetitionTable); i++ {
-		globals.RepetitionTable[i] = 0
+	for index, bottomRow := range globals.FenStartWhiteBottomRow {
+		if bottomRow == s {
+			return index, nil
+		}
 	}
+	return 0, fmt.Errorf("unknown start arrangement %q, expected an index from 0 to %d or a bottom row such as %q",
+		s, len(globals.FenStartWhiteBottomRow)-1, globals.FenStartWhiteBottomRow[0])
+}
 
-	idx := 0 // index in fen string
-	for rank := 0; rank < 8; rank++ {
-		for file := 0; file < 5; file++ {
-			if idx >= len(fen) {
-				break
-			}
-			ch := rune(fen[idx])
-			square := rank*5 + file
-			// piece placement
-			if (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') {
-				piece := globals.ConvertAsciiToConstants[ch]
-				bitoperations.SetBit(&globals.Bitboards[piece], square)
-				idx++
-				continue
-			}
-			// digit → empty squares
-			if ch >= '0' && ch <= '9' {
-				offset := int(ch - '0')
-				file += offset - 1 // -1 because of the loop increment
-				idx++
-				continue
-			}
+// bottomRowSquares is the number of squares a bottom row arrangement covers, it is centred on wider boards
+const bottomRowSquares = 5
+
+// BottomRowFEN returns the FEN of the start position with any bottom row of up to one white knight, bishop, rook,
+// queen and king each, such as "RNKQB", with digits for the empty squares. Unlike the numbered arrangements the row
+// may hold a queen or leave out a piece
+func BottomRowFEN(bottomRow string) (string, error) {
+	squares := 0
+	var seen [globals.NoPiece]bool
+	for _, ch := range bottomRow {
+		if ch >= '1' && ch <= '9' {
+			squares += int(ch - '0')
+			continue
+		}
+		piece, err := globals.ParsePiece(ch)
+		if err != nil || piece == globals.WhitePawn || piece.Color() != globals.WHITE {
+			return "", fmt.Errorf("invalid bottom row %q: %q is not a white piece", bottomRow, ch)
 		}
-		// skip rank separator
-		if idx < len(fen) && fen[idx] == '/' {
-			idx++
+		if seen[piece] {
+			return "", fmt.Errorf("invalid bottom row %q: more than one %s", bottomRow, piece)
 		}
+		seen[piece] = true
+		squares++
 	}
-	// side to move
-	idx++
-	if fen[idx] == 'w' {
-		globals.SideToMove = globals.WHITE
-	} else if fen[idx] == 'b' {
-		globals.SideToMove = globals.BLACK
-	}
-	idx += 2
-	if fen[idx] != '-' {
-		file := int(fen[idx] - 'a')
-		rank := 8 - int(fen[idx+1]-'0')
-		globals.EnPassantSquare = rank*5 + file
-	} else {
-		globals.EnPassantSquare = globals.NoSquare
+	if squares != bottomRowSquares {
+		return "", fmt.Errorf("invalid bottom row %q: it covers %d squares, expected %d",
+			bottomRow, squares, bottomRowSquares)
 	}
-	//fmt.Printf("'%s", fen[idx:])
+	return StartPosFEN(bottomRow), nil
+}
 
-	// init the occupancy bitboards
-	for piece := globals.WhitePawn; piece <= globals.WhiteKing; piece++ {
-		globals.Occupancies[globals.WHITE] |= globals.Bitboards[piece]
-	}
-	globals.Occupancies[globals.BLACK] |= globals.Bitboards[globals.BlackPawn]
-	globals.Occupancies[globals.BOTH] |= globals.Occupancies[globals.WHITE] | globals.Occupancies[globals.BLACK]
+// RandomStartArrangement returns the index of a start arrangement picked at random from the seed, the same seed
+// always picks the same arrangement so a game can be set up again from its seed
+func RandomStartArrangement(seed uint64) int {
+	return rand.New(rand.NewPCG(seed, seed)).IntN(len(globals.FenStartWhiteBottomRow))
+}
 
-	// init the hash key
-	globals.HashKey = GeneratePositionKey()
+// StartPosFEN returns the starting position FEN string for the given white bottom row on the current board. Black
+// fills the top three ranks with pawns and white has a rank of pawns in front of the bottom row. Bottom rows that
+// are narrower than the board are centred with empty squares.
+func StartPosFEN(bottomRow string) string {
+	files, ranks := globals.Geometry.Files, globals.Geometry.Ranks
+	rows := make([]string, 0, ranks)
+	for rank := 0; rank < 3; rank++ {
+		rows = append(rows, strings.Repeat("p", files))
+	}
+	for rank := 3; rank < ranks-2; rank++ {
+		rows = append(rows, strconv.Itoa(files))
+	}
+	rows = append(rows, strings.Repeat("P", files), padFENRow(bottomRow, files))
+	return strings.Join(rows, "/") + " w -"
 }
 
-// GetStartPosFEN returns a random starting position FEN string
-func GetStartPosFEN() string {
-	return "ppppp/ppppp/ppppp/5/5/5/PPPPP/" + globals.FenStartWhiteBottomRow[rand.Intn(len(globals.FenStartWhiteBottomRow))] + " w -"
+// padFENRow centres a FEN row on a board with the given number of files by adding empty squares on both sides
+func padFENRow(row string, files int) string {
+	// expand the row so that every square is a single character
+	var squares []byte
+	for i := 0; i < len(row); i++ {
+		if row[i] >= '0' && row[i] <= '9' {
+			squares = append(squares, strings.Repeat(".", int(row[i]-'0'))...)
+		} else {
+			squares = append(squares, row[i])
+		}
+	}
+	if len(squares) >= files {
+		return row
+	}
+	left := (files - len(squares)) / 2
+	right := files - len(squares) - left
+	expanded := strings.Repeat(".", left) + string(squares) + strings.Repeat(".", right)
+	// collapse the empty squares back into digits
+	var padded strings.Builder
+	empty := 0
+	for i := 0; i < len(expanded); i++ {
+		if expanded[i] == '.' {
+			empty++
+			continue
+		}
+		if empty > 0 {
+			padded.WriteString(strconv.Itoa(empty))
+			empty = 0
+		}
+		padded.WriteByte(expanded[i])
+	}
+	if empty > 0 {
+		padded.WriteString(strconv.Itoa(empty))
+	}
+	return padded.String()
 }
 
 // PrintAttackedSquares prints the attacked squares of the given side to the console
-func PrintAttackedSquares(side int) {
-	fmt.Println()
-	fmt.Println()
-	for rank := 0; rank < 8; rank++ {
+func (pos *Position) PrintAttackedSquares(side globals.Color) {
+	pos.WriteAttackedSquares(os.Stdout, side)
+}
+
+// AttackedSquaresString returns the diagram of the attacked squares PrintAttackedSquares prints
+func (pos *Position) AttackedSquaresString(side globals.Color) string {
+	var diagram strings.Builder
+	pos.WriteAttackedSquares(&diagram, side)
+	return diagram.String()
+}
+
+// WriteAttackedSquares writes the diagram of the squares the given side attacks to w
+func (pos *Position) WriteAttackedSquares(w io.Writer, side globals.Color) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w)
+	for rank := 0; rank < globals.Geometry.Ranks; rank++ {
 		// loop over the board files
-		for file := 0; file < 5; file++ {
-			square := rank*5 + file
+		for file := 0; file < globals.Geometry.Files; file++ {
+			square := globals.Geometry.Square(rank, file)
 			if file == 0 {
-				fmt.Printf("\t%d ", 8-rank)
+				fmt.Fprintf(w, "\t%d ", globals.Geometry.Ranks-rank)
 			}
 
-			fmt.Printf("  %d", IsSquareAttacked(square, side))
+			fmt.Fprintf(w, "  %d", pos.IsSquareAttacked(square, side))
 		}
-		fmt.Println()
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "\t    %s\n", fileLetters())
+	fmt.Fprintln(w)
+}
+
+// fileLetters returns the file letters printed below the board, for example "A  B  C  D  E"
+func fileLetters() string {
+	letters := make([]string, globals.Geometry.Files)
+	for file := range letters {
+		letters[file] = string(rune('A' + file))
 	}
-	fmt.Printf("\t    A  B  C  D  E\n")
-	fmt.Println()
+	return strings.Join(letters, "  ")
 }
 
 // IsSquareAttacked returns 1 if the given square is attacked by a piece of the given side, 0 otherwise
-func IsSquareAttacked(square int, side int) int {
+func (pos *Position) IsSquareAttacked(square globals.Square, side globals.Color) int {
 
 	/*
 		Here we use another trick to check if a square is attacked by a piece of the given side.
@@ -185,59 +328,18 @@ func IsSquareAttacked(square int, side int) int {
 		we get a non-empty set, which means that b6 is attacked by a white pawn. We do the same for d6.
 	*/
 	if side == globals.WHITE {
-		if (globals.PawnAttacks[globals.BLACK][square]&globals.Bitboards[globals.WhitePawn]) != 0 ||
-			(globals.KnightAttacks[square]&globals.Bitboards[globals.WhiteKnight]) != 0 ||
-			(globals.KingAttacks[square]&globals.Bitboards[globals.WhiteKing]) != 0 ||
-			(GetBishopAttacks(square, globals.Occupancies[globals.BOTH])&globals.Bitboards[globals.WhiteBishop]) != 0 ||
-			(GetRookAttacks(square, globals.Occupancies[globals.BOTH])&globals.Bitboards[globals.WhiteRook]) != 0 {
+		if (globals.PawnAttacks[globals.BLACK][square]&pos.Bitboards[globals.WhitePawn]) != 0 ||
+			(globals.KnightAttacks[square]&pos.Bitboards[globals.WhiteKnight]) != 0 ||
+			(globals.KingAttacks[square]&pos.Bitboards[globals.WhiteKing]) != 0 ||
+			(GetBishopAttacks(square, pos.Occupancies[globals.BOTH])&pos.Bitboards[globals.WhiteBishop]) != 0 ||
+			(GetRookAttacks(square, pos.Occupancies[globals.BOTH])&pos.Bitboards[globals.WhiteRook]) != 0 ||
+			(GetQueenAttacks(square, pos.Occupancies[globals.BOTH])&pos.Bitboards[globals.WhiteQueen]) != 0 {
 			return 1
 		}
 	} else if side == globals.BLACK {
-		if (globals.PawnAttacks[globals.WHITE][square] & globals.Bitboards[globals.BlackPawn]) != 0 {
+		if (globals.PawnAttacks[globals.WHITE][square] & pos.Bitboards[globals.BlackPawn]) != 0 {
 			return 1
 		}
 	}
 	return 0
 }
-
-// CopyBoard returns a copy of the current board state
-func CopyBoard() ([6]uint64, [3]uint64, int, int, uint64) {
-	var BitboardsCopy [6]uint64
-	var OccupanciesCopy [3]uint64
-	var SideToMoveCopy, EnPassantSquareCopy int
-	var HashKeyCopy uint64
-	copy(BitboardsCopy[:], globals.Bitboards[:])
-	copy(OccupanciesCopy[:], globals.Occupancies[:])
-	SideToMoveCopy = globals.SideToMove
-	EnPassantSquareCopy = globals.EnPassantSquare
-	HashKeyCopy = globals.HashKey
-	return BitboardsCopy, OccupanciesCopy, SideToMoveCopy, EnPassantSquareCopy, HashKeyCopy
-}
-
-// RestoreBoard restores the board state from a copy
-func RestoreBoard(bitboards [6]uint64, occupancies [3]uint64, sideToMove int, enPassantSquare int, hashKey uint64) {
-	copy(globals.Bitboards[:], bitboards[:])
-	copy(globals.Occupancies[:], occupancies[:])
-	globals.SideToMove = sideToMove
-	globals.EnPassantSquare = enPassantSquare
-	globals.HashKey = hashKey
-}
-
-func IsTerminalPosition() bool {
-	// The black side wins if a black pawn reaches the white bottom row
-	for square := globals.A1; square <= globals.E1; square++ {
-		if bitoperations.GetBit(globals.Bitboards[globals.BlackPawn], square) == 1 {
-			return true
-		}
-	}
-	// The black side wins if all white pieces are captured
-	if globals.Occupancies[globals.WHITE] == 0 {
-		return true
-	}
-	// The white side wins if all white pieces are captured
-	if globals.Occupancies[globals.BLACK] == 0 {
-		return true
-	}
-
-	return false
-}
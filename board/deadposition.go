@@ -0,0 +1,92 @@
+package board
+
+import (
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+/*
+	A dead position is one where no sequence of legal moves, however badly either side plays, can end the game with a
+	win. The proof used here covers the locked pawn structures of the horde game: every pawn stands head to head with
+	another pawn, so no pawn can be pushed, and no capture can ever be made, so the pawns stay locked forever. Then no
+	black pawn can reach the bottom row, black cannot capture the white pieces and white cannot capture the black
+	pawns.
+
+	The pawns are the only obstacles that never move, so the squares a white piece can ever stand on are found by
+	flooding the board from its square with its own moves, stopping at the pawns. The other white pieces are ignored
+	since they can always step aside, which only lets the flood reach more squares. A capture is possible as soon as
+	a flooded piece attacks a black pawn, or a black pawn attacks a square a white piece can reach.
+*/
+
+// IsDeadPosition returns true if the position is proven to be a dead draw: the pawns are locked and no capture can
+// ever be made by either side. A false result does not mean either side can still win
+func (pos *Position) IsDeadPosition() bool {
+	// black has no legal move in a locked position, which only draws when the variant draws stalemates
+	if ActiveVariant.Stalemate != StalemateDraw {
+		return false
+	}
+	whitePawns, blackPawns := pos.Bitboards[globals.WhitePawn], pos.Bitboards[globals.BlackPawn]
+	pawns := whitePawns | blackPawns
+	// every pawn must have a pawn right in front of it, this cheap test rules out almost every position
+	if bitoperations.North(whitePawns)&^pawns != 0 || bitoperations.South(blackPawns)&^pawns != 0 {
+		return false
+	}
+	// an en passant capture may be available
+	if pos.EnPassantSquare != globals.NoSquare {
+		return false
+	}
+	// the squares the black pawns attack, and the pawn captures available now
+	var blackAttacks globals.Bitboard
+	for bitboard := blackPawns; bitboard != 0; {
+		blackAttacks |= globals.PawnAttacks[globals.BLACK][bitoperations.PopLSB(&bitboard)]
+	}
+	if blackAttacks&whitePawns != 0 {
+		return false
+	}
+	for bitboard := whitePawns; bitboard != 0; {
+		if globals.PawnAttacks[globals.WHITE][bitoperations.PopLSB(&bitboard)]&blackPawns != 0 {
+			return false
+		}
+	}
+	// the white pieces must never be able to attack a black pawn or stand on a square a black pawn attacks
+	for piece := globals.WhiteKnight; piece <= globals.WhiteKing; piece++ {
+		for bitboard := pos.Bitboards[piece]; bitboard != 0; {
+			reached, attacked := floodSquares(piece, bitoperations.PopLSB(&bitboard), pawns)
+			if attacked&blackPawns != 0 || reached&blackAttacks != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// floodSquares returns the squares the white piece can reach from the given square by moving across empty squares,
+// with the pawns as walls, and the squares it attacks from any of them
+func floodSquares(piece globals.Piece, from globals.Square, pawns globals.Bitboard) (reached globals.Bitboard, attacked globals.Bitboard) {
+	reached = from.Bit()
+	for frontier := reached; frontier != 0; {
+		targets := pieceAttacks(piece, bitoperations.PopLSB(&frontier), pawns)
+		attacked |= targets
+		next := targets &^ pawns &^ reached
+		reached |= next
+		frontier |= next
+	}
+	return reached, attacked
+}
+
+// pieceAttacks returns the squares the white piece attacks from the given square, the sliders stop at the occupancy
+func pieceAttacks(piece globals.Piece, square globals.Square, occupancy globals.Bitboard) globals.Bitboard {
+	switch piece {
+	case globals.WhiteKnight:
+		return globals.KnightAttacks[square]
+	case globals.WhiteBishop:
+		return GetBishopAttacks(square, occupancy)
+	case globals.WhiteRook:
+		return GetRookAttacks(square, occupancy)
+	case globals.WhiteQueen:
+		return GetQueenAttacks(square, occupancy)
+	case globals.WhiteKing:
+		return globals.KingAttacks[square]
+	}
+	return 0
+}
@@ -0,0 +1,23 @@
+//go:build debug
+
+package board
+
+import "fmt"
+
+/*
+	The debug build checks the board invariants after every move made or taken back, build or run it with
+	`-tags debug`. A broken invariant panics with the moves that led to it, so a bug found by a long search or a
+	fuzzing run can be replayed from the position the game was set up from.
+*/
+
+// debugCheck panics if the board invariants are broken after the action on the move, such as MakeMove on e2e4. The
+// null move actions pass NoMove
+func (pos *Position) debugCheck(action string, move Move) {
+	if err := pos.CheckInvariants(); err != nil {
+		if move != NoMove {
+			action += " " + move.String()
+		}
+		panic(fmt.Sprintf("board invariant broken after %s: %v\nmoves since the position was set up: %s\nboard: %s",
+			action, err, pos.moveSequence(), pos.placement()))
+	}
+}
@@ -0,0 +1,179 @@
+package board
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"zerginator/globals"
+)
+
+/*
+	The custom FEN has the piece placement, the side to move and the en passant square, followed by two optional move
+	counters: the halfmove clock, the number of moves without a pawn move or capture, and the fullmove number, which
+	starts at 1 and goes up after every black move. For example, a FEN string where only a white pawn is on a1 would
+	be "5/5/5/5/5/5/5/P4 w -" on the default board, or "5/5/5/5/5/5/5/P4 w - 0 1" with the counters.
+
+	The placement lists the ranks from the top of the board separated by '/'. Each rank is a run of piece letters and
+	single digits 1-9 for empty squares that must cover exactly the files of the board, so two digits in a row such
+	as "11" are rejected. The errors give the position of the offending character counted from 1.
+*/
+
+// fenField is a whitespace separated field of a FEN string and the index of its first character
+type fenField struct {
+	text  string
+	start int
+}
+
+// splitFEN splits the FEN string into its fields, keeping the index of every field for the error messages
+func splitFEN(fen string) []fenField {
+	var fields []fenField
+	start := -1
+	for i, ch := range fen + " " {
+		if unicode.IsSpace(ch) {
+			if start >= 0 {
+				fields = append(fields, fenField{fen[start:i], start})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	return fields
+}
+
+// ParseFEN parses the FEN string and sets up the position from it, with an empty game history. It returns an error
+// naming the offending character and its position if the FEN is malformed, and leaves the position untouched
+func (pos *Position) ParseFEN(fen string) error {
+	fields := splitFEN(fen)
+	if len(fields) < 3 {
+		return fmt.Errorf("FEN %q has %d fields, expected the placement, the side to move and the en passant square",
+			fen, len(fields))
+	}
+	if len(fields) > 5 {
+		return fmt.Errorf("unexpected field %q at position %d of the FEN", fields[5].text, fields[5].start+1)
+	}
+	parsed := NewPosition()
+	if err := parsed.parsePlacement(fields[0]); err != nil {
+		return err
+	}
+	side, err := globals.ParseColor(fields[1].text)
+	if err != nil {
+		return fmt.Errorf("%w at position %d of the FEN, expected w or b", err, fields[1].start+1)
+	}
+	parsed.SideToMove = side
+	if ep := fields[2]; ep.text != "-" {
+		square, err := globals.ParseSquare(ep.text)
+		if err != nil {
+			return fmt.Errorf("invalid en passant square at position %d of the FEN: %w", ep.start+1, err)
+		}
+		if err := checkEnPassantRow(square, side); err != nil {
+			return fmt.Errorf("invalid en passant square at position %d of the FEN: %w", ep.start+1, err)
+		}
+		parsed.EnPassantSquare = square
+	}
+	if len(fields) > 3 {
+		clock, err := strconv.Atoi(fields[3].text)
+		if err != nil || clock < 0 {
+			return fmt.Errorf("invalid halfmove clock %q at position %d of the FEN", fields[3].text, fields[3].start+1)
+		}
+		parsed.HalfmoveClock = clock
+	}
+	if len(fields) > 4 {
+		number, err := strconv.Atoi(fields[4].text)
+		if err != nil || number < 1 {
+			return fmt.Errorf("invalid fullmove number %q at position %d of the FEN", fields[4].text, fields[4].start+1)
+		}
+		parsed.FullmoveNumber = number
+	}
+	parsed.HashKey = parsed.GeneratePositionKey()
+
+	// the game history starts again, its records are reused
+	parsed.MoveStack = pos.MoveStack[:0]
+	*pos = *parsed
+	return nil
+}
+
+// parsePlacement places the pieces of the placement field on the empty position
+func (pos *Position) parsePlacement(field fenField) error {
+	ranks := strings.Split(field.text, "/")
+	if len(ranks) != globals.Geometry.Ranks {
+		return fmt.Errorf("FEN placement %q has %d ranks, the board has %d", field.text, len(ranks), globals.Geometry.Ranks)
+	}
+	start := field.start // index of the first character of the rank in the FEN string
+	for row, rank := range ranks {
+		rankNumber := globals.Geometry.Ranks - row
+		file := 0
+		for i, ch := range rank {
+			position := start + i + 1
+			if file >= globals.Geometry.Files {
+				return fmt.Errorf("rank %d has more than %d files at %q, position %d of the FEN",
+					rankNumber, globals.Geometry.Files, ch, position)
+			}
+			switch {
+			case ch >= '1' && ch <= '9':
+				if i > 0 && rank[i-1] >= '0' && rank[i-1] <= '9' {
+					return fmt.Errorf("digit %q follows another digit at position %d of the FEN", ch, position)
+				}
+				file += int(ch - '0')
+				if file > globals.Geometry.Files {
+					return fmt.Errorf("rank %d has more than %d files at %q, position %d of the FEN",
+						rankNumber, globals.Geometry.Files, ch, position)
+				}
+			default:
+				piece, err := globals.ParsePiece(ch)
+				if err != nil {
+					return fmt.Errorf("invalid character %q at position %d of the FEN", ch, position)
+				}
+				pos.PutPiece(piece, globals.Geometry.Square(row, file))
+				file++
+			}
+		}
+		if file != globals.Geometry.Files {
+			return fmt.Errorf("rank %d covers %d files, the board has %d, at position %d of the FEN",
+				rankNumber, file, globals.Geometry.Files, start+1)
+		}
+		start += len(rank) + 1 // skip the rank and the '/'
+	}
+	return nil
+}
+
+// ToFEN returns the FEN string of the position, ParseFEN of it sets up the same position. The halfmove clock is
+// written when it is not zero or the fullmove number is set, and the fullmove number when it is set, so a FEN read
+// without the counters is written back without them
+func (pos *Position) ToFEN() string {
+	var fen strings.Builder
+	for row := 0; row < globals.Geometry.Ranks; row++ {
+		if row > 0 {
+			fen.WriteByte('/')
+		}
+		empty := 0
+		for file := 0; file < globals.Geometry.Files; file++ {
+			piece := pos.PieceAt(globals.Geometry.Square(row, file))
+			if piece == globals.NoPiece {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				fen.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			fen.WriteString(piece.String())
+		}
+		if empty > 0 {
+			fen.WriteString(strconv.Itoa(empty))
+		}
+	}
+	side := "w"
+	if pos.SideToMove == globals.BLACK {
+		side = "b"
+	}
+	fen.WriteString(" " + side + " " + pos.EnPassantSquare.String())
+	if pos.HalfmoveClock != 0 || pos.FullmoveNumber != 0 {
+		fen.WriteString(" " + strconv.Itoa(pos.HalfmoveClock))
+	}
+	if pos.FullmoveNumber != 0 {
+		fen.WriteString(" " + strconv.Itoa(pos.FullmoveNumber))
+	}
+	return fen.String()
+}
@@ -0,0 +1,101 @@
+package board
+
+import (
+	"math/rand/v2"
+	"testing"
+	"zerginator/globals"
+)
+
+func TestParseFENRejectsEnPassantSquares(t *testing.T) {
+	for _, fen := range []string{
+		"ppppp/ppppp/ppppp/5/5/5/PPPPP/RNK1B b a8",  // top row
+		"ppppp/ppppp/ppppp/5/5/5/PPPPP/RNK1B b a1",  // bottom row
+		"ppppp/ppppp/ppppp/5/P4/5/1PPPP/RNK1B w a3", // white to move
+		"ppppp/ppppp/ppppp/5/P4/5/1PPPP/RNK1B b a5", // not behind a double push
+	} {
+		pos := NewPosition()
+		if err := pos.ParseFEN(fen); err == nil {
+			t.Errorf("ParseFEN(%q) accepted the en passant square", fen)
+		}
+	}
+}
+
+func TestParseFENAcceptsEnPassantSquare(t *testing.T) {
+	pos := NewPosition()
+	if err := pos.ParseFEN("ppppp/ppppp/ppppp/5/P4/5/1PPPP/RNK1B b a3"); err != nil {
+		t.Fatal(err)
+	}
+	if pos.EnPassantSquare != globals.A3 {
+		t.Errorf("en passant square is %s, expected a3", pos.EnPassantSquare)
+	}
+}
+
+func TestGenerateMovesWithEnPassantSquareOnTopRow(t *testing.T) {
+	// ParseFEN rejects an en passant square on the top row, one set on the position afterwards must not crash the
+	// generator or give an en passant move
+	pos := NewPosition()
+	if err := pos.ParseFEN("ppppp/ppppp/ppppp/5/5/5/PPPPP/RNK1B b -"); err != nil {
+		t.Fatal(err)
+	}
+	var expected Moves
+	pos.GenerateMoves(&expected)
+	pos.EnPassantSquare = globals.A8
+	var moveList Moves
+	pos.GenerateMoves(&moveList)
+	if moveList.Count != expected.Count {
+		t.Errorf("generated %d moves with the en passant square a8, %d without", moveList.Count, expected.Count)
+	}
+	for i := 0; i < moveList.Count; i++ {
+		if move := moveList.Moves[i]; move.IsEnPassant() {
+			t.Errorf("generated the en passant move %s", move)
+		}
+	}
+}
+
+func TestFENRoundTrip(t *testing.T) {
+	fens := []string{
+		globals.FenDebugStartPosition,
+		globals.FenDebug2,
+		globals.FenDebug3,
+		globals.FenDebug4,
+		"ppppp/ppppp/ppppp/5/P4/5/1PPPP/RNK1B b a3",
+	}
+	for _, bottomRow := range globals.FenStartWhiteBottomRow {
+		fens = append(fens, StartPosFEN(bottomRow))
+	}
+	pos := NewPosition()
+	for _, fen := range fens {
+		// the move counters are written back as they were read, or left out if they were
+		for _, counters := range []string{"", " 7", " 0 1", " 12 34"} {
+			if err := pos.ParseFEN(fen + counters); err != nil {
+				t.Errorf("ParseFEN(%q): %v", fen+counters, err)
+				continue
+			}
+			if written := pos.ToFEN(); written != fen+counters {
+				t.Errorf("%q is written back as %q", fen+counters, written)
+			}
+		}
+	}
+}
+
+func TestFENRoundTripOfPlayedPositions(t *testing.T) {
+	// the FEN written after every move of a random game sets up the same board, with the move counters running
+	random := rand.New(rand.NewPCG(selfTestSeed, selfTestSeed))
+	pos, played := NewPosition(), NewPosition()
+	for _, fen := range selfTestStartPositions() {
+		if err := played.ParseFEN(fen + " 0 1"); err != nil {
+			t.Fatal(err)
+		}
+		for plies := 0; plies < selfTestPlies && played.randomLegalMove(random) != NoMove; plies++ {
+			written := played.ToFEN()
+			if err := pos.ParseFEN(written); err != nil {
+				t.Errorf("%q written after %s: %v", written, played.moveSequence(), err)
+				break
+			}
+			if differences := pos.state().differences(played.state()); differences != "" {
+				t.Errorf("%q written after %s sets up a different board: %s", written, played.moveSequence(), differences)
+				break
+			}
+		}
+	}
+}
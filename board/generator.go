@@ -0,0 +1,188 @@
+package board
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+/*
+	The position generator plays random games from the start arrangements and takes a position from each of them, so
+	every position it returns can occur in a game. A game starts from the chosen arrangement, or from one picked at
+	random, and the position is taken after a number of plies picked between MinPly and MaxPly. When a material
+	signature is given the position is instead the first one within the ply range with exactly that material, such as
+	"KRP3p5" for a king, a rook, three white pawns and five black pawns. A game that ends or runs out of plies before
+	a position is taken is thrown away and another one is played.
+
+	The moves are picked at random among the legal moves, captures and promotions being CaptureWeight times as likely
+	as the other moves, which gets the games to the middle and end games sooner. The same options and seed always
+	generate the same positions.
+*/
+
+// GeneratorOptions are the options of the position generator
+type GeneratorOptions struct {
+	// Seed is the seed of the random arrangements and moves
+	Seed uint64
+	// Arrangement is the index of the start arrangement of the games, -1 picks one at random for every game
+	Arrangement int
+	// MinPly and MaxPly are the range of plies after which a position is taken
+	MinPly, MaxPly int
+	// Material is the material signature of the positions, empty for any material
+	Material string
+	// CaptureWeight is how many times as likely as a quiet move a capture or promotion is picked, 1 for uniform
+	CaptureWeight int
+}
+
+// DefaultGeneratorOptions returns the generator options for positions of any material after 10 to 60 plies from
+// random arrangements, picking the moves uniformly
+func DefaultGeneratorOptions() GeneratorOptions {
+	return GeneratorOptions{Arrangement: -1, MinPly: 10, MaxPly: 60, CaptureWeight: 1}
+}
+
+// generatorGames is the number of games the generator plays for a position before it gives up
+const generatorGames = 1000
+
+// PositionGenerator generates random positions that can occur in a game
+type PositionGenerator struct {
+	options  GeneratorOptions
+	material [globals.NoPiece]int // the piece counts of the material signature
+	random   *rand.Rand
+	pos      *Position
+}
+
+// NewPositionGenerator returns a position generator with the given options, or an error if they are invalid
+func NewPositionGenerator(options GeneratorOptions) (*PositionGenerator, error) {
+	if options.Arrangement != -1 {
+		if _, err := StartPositionFEN(options.Arrangement); err != nil {
+			return nil, err
+		}
+	}
+	if options.MinPly < 0 || options.MaxPly < options.MinPly {
+		return nil, fmt.Errorf("invalid ply range %d to %d", options.MinPly, options.MaxPly)
+	}
+	if options.CaptureWeight < 1 {
+		return nil, fmt.Errorf("capture weight %d is below 1", options.CaptureWeight)
+	}
+	g := &PositionGenerator{
+		options: options,
+		random:  rand.New(rand.NewPCG(options.Seed, options.Seed)),
+		pos:     NewPosition(),
+	}
+	if options.Material != "" {
+		material, err := parseMaterial(options.Material)
+		if err != nil {
+			return nil, err
+		}
+		g.material = material
+	}
+	return g, nil
+}
+
+// parseMaterial parses a material signature, piece letters each followed by an optional count, such as "KRP3p5"
+func parseMaterial(signature string) ([globals.NoPiece]int, error) {
+	var material [globals.NoPiece]int
+	for i := 0; i < len(signature); {
+		piece, err := globals.ParsePiece(rune(signature[i]))
+		if err != nil {
+			return material, fmt.Errorf("invalid material signature %q: %w", signature, err)
+		}
+		i++
+		count := 1
+		if digits := i; digits < len(signature) && signature[digits] >= '0' && signature[digits] <= '9' {
+			for i < len(signature) && signature[i] >= '0' && signature[i] <= '9' {
+				i++
+			}
+			count, _ = strconv.Atoi(signature[digits:i])
+		}
+		material[piece] += count
+	}
+	return material, nil
+}
+
+// hasMaterial returns true if the position has exactly the material of the signature
+func (g *PositionGenerator) hasMaterial() bool {
+	for piece := globals.WhitePawn; piece < globals.NoPiece; piece++ {
+		if bitoperations.CountBits(g.pos.Bitboards[piece]) != g.material[piece] {
+			return false
+		}
+	}
+	return true
+}
+
+// Next plays random games until one of them reaches a position matching the options and returns its FEN, with the
+// move counters. It returns an error if no game reaches such a position
+func (g *PositionGenerator) Next() (string, error) {
+	for game := 0; game < generatorGames; game++ {
+		arrangement := g.options.Arrangement
+		if arrangement == -1 {
+			arrangement = g.random.IntN(len(globals.FenStartWhiteBottomRow))
+		}
+		fen, _ := StartPositionFEN(arrangement)
+		if err := g.pos.ParseFEN(fen); err != nil {
+			return "", err
+		}
+		g.pos.FullmoveNumber = 1
+		if g.playGame() {
+			return g.pos.ToFEN(), nil
+		}
+	}
+	return "", fmt.Errorf("no position matching the options in %d games", generatorGames)
+}
+
+// playGame plays random moves until the position matches the options and returns true, or returns false if the game
+// ends or runs past MaxPly first
+func (g *PositionGenerator) playGame() bool {
+	target := g.options.MinPly + g.random.IntN(g.options.MaxPly-g.options.MinPly+1)
+	for ply := 0; ; ply++ {
+		if result, _ := g.pos.Result(); result != Ongoing {
+			return false
+		}
+		if g.options.Material != "" {
+			if ply >= g.options.MinPly && g.hasMaterial() {
+				return true
+			}
+			if ply == g.options.MaxPly {
+				return false
+			}
+		} else if ply == target {
+			return true
+		}
+		if g.pos.weightedRandomMove(g.random, g.options.CaptureWeight) == NoMove {
+			return false
+		}
+	}
+}
+
+// weightedRandomMove makes a legal move picked at random, captures and promotions being weight times as likely as
+// the other moves, and returns it, or NoMove if there is no legal move
+func (pos *Position) weightedRandomMove(random *rand.Rand, weight int) Move {
+	moveList := Moves{}
+	pos.GenerateMoves(&moveList)
+	var weights [len(moveList.Moves)]int
+	total := 0
+	for i := 0; i < moveList.Count; i++ {
+		move := moveList.Moves[i]
+		if pos.MakeMove(move, globals.AllMoves) == 0 {
+			continue // an illegal move is never picked
+		}
+		pos.UnMakeMove()
+		weights[i] = 1
+		if move.IsCapture() || move.IsPromotion() {
+			weights[i] = weight
+		}
+		total += weights[i]
+	}
+	if total == 0 {
+		return NoMove
+	}
+	pick := random.IntN(total)
+	for i := 0; i < moveList.Count; i++ {
+		if pick -= weights[i]; pick < 0 {
+			pos.MakeMove(moveList.Moves[i], globals.AllMoves)
+			return moveList.Moves[i]
+		}
+	}
+	return NoMove
+}
@@ -6,48 +6,47 @@ import (
 )
 
 // PieceKeys is the hash keys for each piece on each square [piece][square]
-var PieceKeys [6][40]uint64
+var PieceKeys [globals.NoPiece][globals.MaxSquares]uint64
 
 // EnPassantKeys is the hash keys for each en passant square
-var EnPassantKeys [40]uint64
+var EnPassantKeys [globals.MaxSquares]uint64
 
 // SideKey is the hash key for the side to move
 var SideKey uint64
 
 // InitRandomKeys initializes the hash keys
 func InitRandomKeys() {
-	randomState = 1804289383
+	randomState = randomSeed
 	for piece := globals.WhitePawn; piece <= globals.BlackPawn; piece++ {
-		for square := 0; square < 40; square++ {
+		for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
 			PieceKeys[piece][square] = GetRandomUInt64()
 			//fmt.Printf("%x\n", PieceKeys[piece][square])
 		}
 	}
-	for square := 0; square < 40; square++ {
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
 		EnPassantKeys[square] = GetRandomUInt64()
 	}
 	SideKey = GetRandomUInt64()
 }
 
 // GeneratePositionKey generates the hash key for the current position
-func GeneratePositionKey() uint64 {
+func (pos *Position) GeneratePositionKey() uint64 {
 	var finalKey uint64
-	var bitboard uint64
+	var bitboard globals.Bitboard
 	for piece := globals.WhitePawn; piece <= globals.BlackPawn; piece++ {
-		bitboard = globals.Bitboards[piece]
+		bitboard = pos.Bitboards[piece]
 		for bitboard != 0 {
-			square := bitoperations.GetLeastSignificantBitIndex(bitboard)
+			square := bitoperations.PopLSB(&bitboard)
 			// hash piece on square
 			finalKey ^= PieceKeys[piece][square]
-			bitoperations.PopBit(&bitboard, square)
 		}
 	}
-	if globals.EnPassantSquare != globals.NoSquare {
+	if pos.EnPassantSquare != globals.NoSquare {
 		// hash en passant square
-		finalKey ^= EnPassantKeys[globals.EnPassantSquare]
+		finalKey ^= EnPassantKeys[pos.EnPassantSquare]
 	}
 	// hash the side only if it is black to move
-	if globals.SideToMove == globals.BLACK {
+	if pos.SideToMove == globals.BLACK {
 		finalKey ^= SideKey
 	}
 	return finalKey
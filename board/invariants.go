@@ -0,0 +1,117 @@
+package board
+
+import (
+	"fmt"
+	"strings"
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+// CheckInvariants returns an error describing the first broken invariant of the board representation: the hash key
+// must match GeneratePositionKey, the piece bitboards must not overlap, the occupancies must be the union of the piece
+// bitboards, the mailbox must agree with the bitboards, the en passant square must sit behind a white pawn that just
+// double pushed, and no white pawn may stand on the top rank
+func (pos *Position) CheckInvariants() error {
+	if key := pos.GeneratePositionKey(); pos.HashKey != key {
+		return fmt.Errorf("hash key is %x, GeneratePositionKey gives %x", pos.HashKey, key)
+	}
+	var occupancies [2]globals.Bitboard
+	for piece := globals.WhitePawn; piece <= globals.BlackPawn; piece++ {
+		if pos.Bitboards[piece]&^globals.BoardMask != 0 {
+			return fmt.Errorf("%s bitboard has squares off the board", piece)
+		}
+		for other := piece + 1; other <= globals.BlackPawn; other++ {
+			if overlap := pos.Bitboards[piece] & pos.Bitboards[other]; overlap != 0 {
+				return fmt.Errorf("%s and %s bitboards overlap on %s", piece, other, overlap)
+			}
+		}
+		occupancies[piece.Color()] |= pos.Bitboards[piece]
+	}
+	if pos.Occupancies[globals.WHITE] != occupancies[globals.WHITE] ||
+		pos.Occupancies[globals.BLACK] != occupancies[globals.BLACK] ||
+		pos.Occupancies[globals.BOTH] != occupancies[globals.WHITE]|occupancies[globals.BLACK] {
+		return fmt.Errorf("occupancies %s/%s/%s are not the union of the piece bitboards",
+			pos.Occupancies[globals.WHITE], pos.Occupancies[globals.BLACK], pos.Occupancies[globals.BOTH])
+	}
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
+		piece := globals.NoPiece
+		for p := globals.WhitePawn; p <= globals.BlackPawn; p++ {
+			if bitoperations.GetBit(pos.Bitboards[p], square) == 1 {
+				piece = p
+			}
+		}
+		if pos.Mailbox[square] != piece {
+			return fmt.Errorf("mailbox has %s on %s, the bitboards have %s", pos.Mailbox[square], square, piece)
+		}
+	}
+	if pos.EnPassantSquare != globals.NoSquare {
+		if err := pos.checkEnPassantSquare(); err != nil {
+			return err
+		}
+	}
+	if pawns := pos.Bitboards[globals.WhitePawn] & globals.RowMasks[0]; pawns != 0 {
+		return fmt.Errorf("white pawn on the top rank %s", pawns)
+	}
+	return nil
+}
+
+// checkEnPassantSquare checks that the en passant square is an empty square on the row a white double pawn push
+// crosses, with black to move and the white pawn that made the push right in front of it
+func (pos *Position) checkEnPassantSquare() error {
+	square := pos.EnPassantSquare
+	if err := checkEnPassantRow(square, pos.SideToMove); err != nil {
+		return err
+	}
+	if pos.PieceAt(square) != globals.NoPiece {
+		return fmt.Errorf("en passant square %s is occupied", square)
+	}
+	if pos.PieceAt(square-globals.Square(globals.Geometry.Files)) != globals.WhitePawn {
+		return fmt.Errorf("en passant square %s has no white pawn in front of it", square)
+	}
+	return nil
+}
+
+// checkEnPassantRow checks that the en passant square is on the row a white double pawn push crosses and that black
+// is to move, which is all a FEN can get wrong about the square without looking at the pieces
+func checkEnPassantRow(square globals.Square, side globals.Color) error {
+	if square < 0 || square >= globals.Geometry.Squares() {
+		return fmt.Errorf("en passant square %d is off the board", square)
+	}
+	if side != globals.BLACK {
+		return fmt.Errorf("en passant square %s with white to move", square)
+	}
+	if globals.Geometry.Rank(square) != globals.Geometry.Ranks-3 {
+		return fmt.Errorf("en passant square %s is not on the row a double pawn push crosses", square)
+	}
+	return nil
+}
+
+// moveSequence returns the moves made since the position was set up, null moves are written as "null"
+func (pos *Position) moveSequence() string {
+	moves := make([]string, pos.MoveCount)
+	for i := range moves {
+		if move := pos.MoveStack[i].move; move == NoMove {
+			moves[i] = "null"
+		} else {
+			moves[i] = move.String()
+		}
+	}
+	return strings.Join(moves, " ")
+}
+
+// placement returns the piece placement of the board row by row from the top, with "." for empty squares
+func (pos *Position) placement() string {
+	rows := make([]string, globals.Geometry.Ranks)
+	for rank := range rows {
+		var row strings.Builder
+		for file := 0; file < globals.Geometry.Files; file++ {
+			if piece := pos.PieceAt(globals.Geometry.Square(rank, file)); piece != globals.NoPiece {
+				row.WriteString(piece.String())
+			} else {
+				row.WriteString(".")
+			}
+		}
+		rows[rank] = row.String()
+	}
+	return strings.Join(rows, "/")
+}
@@ -10,8 +10,11 @@ import (
 This file contains the magic number generation algorithm for the bishop and rook pieces.
 */
 
+// randomSeed is the first state of the pseudo random number generator
+const randomSeed uint32 = 1804289383
+
 // state is a pseudo random number state
-var randomState uint32 = 1804289383
+var randomState = randomSeed
 
 // GetRandomUInt32 generates a 32-bit pseudo legal number
 func GetRandomUInt32() uint32 {
@@ -40,10 +43,10 @@ func GenerateMagicNumber() uint64 {
 }
 
 // FindMagicNumber finds an appropriate magic number
-func FindMagicNumber(square int, relevantBits int, isBishop int) uint64 {
-	var occupancies [4096]uint64
-	var attacks [4096]uint64
-	var attackMask uint64
+func FindMagicNumber(square globals.Square, relevantBits int, isBishop int) uint64 {
+	var occupancies [4096]globals.Bitboard
+	var attacks [4096]globals.Bitboard
+	var attackMask globals.Bitboard
 
 	if isBishop == 1 {
 		attackMask = MaskBishopAttacks(square)
@@ -68,15 +71,15 @@ func FindMagicNumber(square int, relevantBits int, isBishop int) uint64 {
 		magicNumber := GenerateMagicNumber()
 
 		// heuristic filter, different from the original algorithm
-		if bitoperations.CountBits((attackMask*magicNumber)&0xFE00000000000000) < 5 {
+		if bitoperations.CountBits(globals.Bitboard(uint64(attackMask)*magicNumber)&0xFE00000000000000) < 5 {
 			continue
 		}
 
-		usedAttacks := make([]uint64, 4096) // reset for each candidate
+		usedAttacks := make([]globals.Bitboard, 4096) // reset for each candidate
 		fail := 0
 
 		for index := 0; index < occupancyIndices; index++ {
-			magicIndex := int((occupancies[index] * magicNumber) >> (64 - relevantBits))
+			magicIndex := int((uint64(occupancies[index]) * magicNumber) >> (64 - relevantBits))
 
 			if usedAttacks[magicIndex] == 0 {
 				usedAttacks[magicIndex] = attacks[index]
@@ -95,19 +98,18 @@ func FindMagicNumber(square int, relevantBits int, isBishop int) uint64 {
 	return 0
 }
 
-// InitMagicNumbers initializes the magic numbers for bishops and rooks
+// InitMagicNumbers searches the magic numbers for bishops and rooks on the current board geometry. The search starts
+// from the same random state every time, so a geometry always gets the same magic numbers
 func InitMagicNumbers() {
-	//fmt.Println("Bishop magic numbers:")
-	for square := 0; square < 40; square++ {
-		// init Rook magic numbers
-		fmt.Printf("0x%x,\n", FindMagicNumber(square, globals.BishopRelevantOccupancyCount[square], globals.BISHOP))
-		globals.BishopMagicNumbers[square] = FindMagicNumber(square, globals.BishopRelevantOccupancyCount[square], globals.BISHOP)
+	randomState = randomSeed
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
+		// init Bishop magic numbers
+		relevantBits := bitoperations.CountBits(MaskBishopAttacks(square))
+		globals.BishopMagicNumbers[square] = FindMagicNumber(square, relevantBits, globals.BISHOP)
 	}
-	fmt.Println()
-	//fmt.Println("Rook magic numbers:")
-	for square := 0; square < 40; square++ {
+	for square := globals.Square(0); square < globals.Geometry.Squares(); square++ {
 		// init Rook magic numbers
-		fmt.Printf("0x%x,\n", FindMagicNumber(square, globals.RookRelevantOccupancyCount[square], globals.ROOK))
-		globals.RookMagicNumbers[square] = FindMagicNumber(square, globals.RookRelevantOccupancyCount[square], globals.ROOK)
+		relevantBits := bitoperations.CountBits(MaskRookAttacks(square))
+		globals.RookMagicNumbers[square] = FindMagicNumber(square, relevantBits, globals.ROOK)
 	}
 }
@@ -0,0 +1,13 @@
+package board
+
+import (
+	"os"
+	"testing"
+)
+
+// TestMain initialises the attack tables and hash keys of the default board before the tests run
+func TestMain(m *testing.M) {
+	InitAttackTables()
+	InitRandomKeys()
+	os.Exit(m.Run())
+}
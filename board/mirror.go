@@ -0,0 +1,77 @@
+package board
+
+import (
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+/*
+	The rules of the horde game do not tell the a-file from the e-file, so a position and its left to right mirror
+	image have the same moves, perft counts and evaluation, and many of the start arrangements are mirror images of
+	each other, such as "RNK1B" and "B1KNR". The canonical key is the smaller of the hash keys of a position and of
+	its mirror image, so tables keyed by it share their entries between the two. The transposition table of the search
+	is keyed by it, and stores its best moves mirrored with MirrorMove for the position whose key is the larger one.
+*/
+
+// MirrorMove returns the move mirrored left to right, with its source and target squares on the opposite files
+func MirrorMove(move Move) Move {
+	if move == NoMove {
+		return NoMove
+	}
+	source, target := globals.MirrorFileSquare[move.Source()], globals.MirrorFileSquare[move.Target()]
+	return move&^0xfff | Move(source) | Move(target)<<6
+}
+
+// Mirrored returns a new position that is the position mirrored left to right, with the same side to move and move
+// counters and an empty game history
+func (pos *Position) Mirrored() *Position {
+	mirrored := NewPosition()
+	for piece := globals.WhitePawn; piece <= globals.BlackPawn; piece++ {
+		for bitboard := pos.Bitboards[piece]; bitboard != 0; {
+			mirrored.PutPiece(piece, globals.MirrorFileSquare[bitoperations.PopLSB(&bitboard)])
+		}
+	}
+	mirrored.SideToMove = pos.SideToMove
+	if pos.EnPassantSquare != globals.NoSquare {
+		mirrored.EnPassantSquare = globals.MirrorFileSquare[pos.EnPassantSquare]
+	}
+	mirrored.HalfmoveClock = pos.HalfmoveClock
+	mirrored.FullmoveNumber = pos.FullmoveNumber
+	mirrored.HashKey = mirrored.GeneratePositionKey()
+	return mirrored
+}
+
+// MirrorKey returns the hash key of the position mirrored left to right without setting up the mirrored position
+func (pos *Position) MirrorKey() uint64 {
+	var key uint64
+	for piece := globals.WhitePawn; piece <= globals.BlackPawn; piece++ {
+		for bitboard := pos.Bitboards[piece]; bitboard != 0; {
+			key ^= PieceKeys[piece][globals.MirrorFileSquare[bitoperations.PopLSB(&bitboard)]]
+		}
+	}
+	if pos.EnPassantSquare != globals.NoSquare {
+		key ^= EnPassantKeys[globals.MirrorFileSquare[pos.EnPassantSquare]]
+	}
+	if pos.SideToMove == globals.BLACK {
+		key ^= SideKey
+	}
+	return key
+}
+
+// CanonicalKey returns the same key for a position and its mirror image, the smaller of their two hash keys
+func (pos *Position) CanonicalKey() uint64 {
+	return min(pos.HashKey, pos.MirrorKey())
+}
+
+// MirrorStartArrangement returns the index of the start arrangement whose bottom row is the mirror image of the
+// bottom row of the given arrangement. On the default board its start position is the mirrored start position
+func MirrorStartArrangement(index int) (int, error) {
+	if _, err := StartPositionFEN(index); err != nil {
+		return 0, err
+	}
+	bottomRow := []byte(globals.FenStartWhiteBottomRow[index])
+	for i, j := 0, len(bottomRow)-1; i < j; i, j = i+1, j-1 {
+		bottomRow[i], bottomRow[j] = bottomRow[j], bottomRow[i]
+	}
+	return ParseStartArrangement(string(bottomRow))
+}
@@ -0,0 +1,159 @@
+package board
+
+import (
+	"testing"
+	"zerginator/globals"
+)
+
+// mirrorFENs are positions and their left to right mirror images
+var mirrorFENs = []struct {
+	fen, mirror string
+}{
+	{globals.FenDebugStartPosition, "ppppp/ppppp/ppppp/5/5/5/PPPPP/B1KNR w -"},
+	{globals.FenDebug2, "ppppp/p1ppp/1p2p/ppppP/3P1/P1NR1/BPP2/2K2 w -"},
+	{globals.FenDebug3, "ppppp/p1ppp/1p2p/pNppP/1P1P1/P2R1/B1P2/2K2 b b3"},
+	{globals.FenDebug4, "3p1/2P2/3RP/pP3/3pP/2B2/1p3/K1PN1 b e3"},
+}
+
+// findMove returns the move of the position written as in UCI, or NoMove if the position has no such move
+func findMove(pos *Position, uci string) Move {
+	moveList := Moves{}
+	pos.GenerateMoves(&moveList)
+	for i := 0; i < moveList.Count; i++ {
+		if moveList.Moves[i].String() == uci {
+			return moveList.Moves[i]
+		}
+	}
+	return NoMove
+}
+
+func TestMirrored(t *testing.T) {
+	for _, test := range mirrorFENs {
+		pos := NewPosition()
+		if err := pos.ParseFEN(test.fen); err != nil {
+			t.Fatal(err)
+		}
+		mirrored := pos.Mirrored()
+		if got := mirrored.ToFEN(); got != test.mirror {
+			t.Errorf("mirror image of %q is %q, expected %q", test.fen, got, test.mirror)
+		}
+		if err := mirrored.CheckInvariants(); err != nil {
+			t.Errorf("mirror image of %q: %v", test.fen, err)
+		}
+		if mirrored.HashKey != pos.MirrorKey() {
+			t.Errorf("mirror image of %q has the hash key %x, MirrorKey gives %x", test.fen, mirrored.HashKey, pos.MirrorKey())
+		}
+		if mirrored.CanonicalKey() != pos.CanonicalKey() {
+			t.Errorf("%q and its mirror image have the canonical keys %x and %x",
+				test.fen, pos.CanonicalKey(), mirrored.CanonicalKey())
+		}
+		if got := mirrored.Mirrored().ToFEN(); got != pos.ToFEN() {
+			t.Errorf("mirroring %q twice gives %q", test.fen, got)
+		}
+	}
+}
+
+func TestMirrorPerft(t *testing.T) {
+	const depth = 3
+	for _, test := range mirrorFENs {
+		pos, mirrored := NewPosition(), NewPosition()
+		if err := pos.ParseFEN(test.fen); err != nil {
+			t.Fatal(err)
+		}
+		if err := mirrored.ParseFEN(test.mirror); err != nil {
+			t.Fatal(err)
+		}
+		nodes, leafNodes := pos.PerftDriver(depth)
+		if mirrorNodes, mirrorLeafNodes := mirrored.PerftDriver(depth); mirrorNodes != nodes || mirrorLeafNodes != leafNodes {
+			t.Errorf("perft %d of %q counted %d nodes and %d leaf nodes, of its mirror image %q %d and %d",
+				depth, test.fen, nodes, leafNodes, test.mirror, mirrorNodes, mirrorLeafNodes)
+		}
+	}
+}
+
+func TestMirrorStartArrangement(t *testing.T) {
+	for _, test := range []struct {
+		arrangement, mirror string
+		depth, leafNodes    int // the perft leaf node count of both start positions
+	}{
+		{"RNK1B", "B1KNR", 4, 5544},
+		{"1RKBN", "NBKR1", 4, 4722},
+	} {
+		index, err := ParseStartArrangement(test.arrangement)
+		if err != nil {
+			t.Errorf("%s: %v", test.arrangement, err)
+			continue
+		}
+		expected, _ := ParseStartArrangement(test.mirror)
+		if mirror, err := MirrorStartArrangement(index); err != nil || mirror != expected {
+			t.Errorf("mirror arrangement of %s is %d (%v), expected %d, %s", test.arrangement, mirror, err, expected, test.mirror)
+		}
+		for _, arrangement := range []int{index, expected} {
+			fen, _ := StartPositionFEN(arrangement)
+			pos := NewPosition()
+			if err := pos.ParseFEN(fen); err != nil {
+				t.Fatal(err)
+			}
+			if _, leafNodes := pos.PerftDriver(test.depth); leafNodes != test.leafNodes {
+				t.Errorf("perft %d of %s counted %d leaf nodes, expected %d",
+					test.depth, globals.FenStartWhiteBottomRow[arrangement], leafNodes, test.leafNodes)
+			}
+		}
+	}
+}
+
+func TestMirrorMove(t *testing.T) {
+	for _, test := range []struct {
+		fen, move, mirror string
+	}{
+		{globals.FenDebugStartPosition, "a2a3", "e2e3"},
+		{globals.FenDebug2, "e2b5", "a2d5"},
+		{globals.FenDebug3, "e5d4", "a5b4"},
+		{globals.FenDebug4, "b4a3", "d4e3"}, // en passant
+	} {
+		pos := NewPosition()
+		if err := pos.ParseFEN(test.fen); err != nil {
+			t.Fatal(err)
+		}
+		move := findMove(pos, test.move)
+		if move == NoMove {
+			t.Errorf("%q has no move %s", test.fen, test.move)
+			continue
+		}
+		mirrored := pos.Mirrored()
+		if got, expected := MirrorMove(move), findMove(mirrored, test.mirror); got != expected || expected == NoMove {
+			t.Errorf("%s in %q mirrors to %s, expected %s", test.move, test.fen, got, test.mirror)
+		}
+	}
+	if MirrorMove(NoMove) != NoMove {
+		t.Errorf("NoMove mirrors to %s", MirrorMove(NoMove))
+	}
+}
+
+func TestMirrorMoveIsLegalInTheMirrorImage(t *testing.T) {
+	for _, test := range mirrorFENs {
+		pos := NewPosition()
+		if err := pos.ParseFEN(test.fen); err != nil {
+			t.Fatal(err)
+		}
+		mirrored := pos.Mirrored()
+		moveList := Moves{}
+		pos.GenerateMoves(&moveList)
+		for i := 0; i < moveList.Count; i++ {
+			move := moveList.Moves[i]
+			if pos.MakeMove(move, globals.AllMoves) == 0 {
+				continue
+			}
+			pos.UnMakeMove()
+			mirror := MirrorMove(move)
+			if twice := MirrorMove(mirror); twice != move {
+				t.Errorf("%q: mirroring %s twice gives %s", test.fen, move, twice)
+			}
+			if !mirrored.IsPseudoLegal(mirror) || mirrored.MakeMove(mirror, globals.AllMoves) == 0 {
+				t.Errorf("%q: %s mirrors to %s, which is not legal in the mirror image %q", test.fen, move, mirror, test.mirror)
+				continue
+			}
+			mirrored.UnMakeMove()
+		}
+	}
+}
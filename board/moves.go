@@ -2,64 +2,87 @@ package board
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 	"zerginator/bitoperations"
 	"zerginator/globals"
 )
 
+// Move is a move packed into a single integer, see EncodeMove for the layout
+type Move uint64
+
+// NoMove is the empty move, it is used when no move is available
+const NoMove Move = 0
+
 // Moves struct holds an array of moves and a count of the number of moves
 type Moves struct {
-	Moves [256]uint64
+	Moves [256]Move
 	Count int
 }
 
-// MoveRecord struct holds the information needed to undo a move
+// MoveRecord struct holds the information needed to undo a move, NoMove is recorded for a null move
 type MoveRecord struct {
-	move            uint64
-	enPassantSquare int
-	sideToMove      int
-	occupancies     [3]uint64
-	hashKey         uint64
+	move              Move
+	enPassantSquare   globals.Square
+	hashKey           uint64
+	irreversibleIndex int
+	halfmoveClock     int
 }
 
-// MoveStack array that holds the move records for undoing moves
-var MoveStack []MoveRecord
-
 // AddMove adds a move to the move list
-func (m *Moves) AddMove(move uint64) {
+func (m *Moves) AddMove(move Move) {
 	m.Moves[m.Count] = move
 	m.Count++
 }
 
 // PrintMove prints a move to the console
-func PrintMove(move uint64) {
-	fmt.Printf("%s%s%s", globals.SquareToCoord[GetMoveSource(move)], globals.SquareToCoord[GetMoveTarget(move)], globals.PromotedPieces[GetMovePromotedPiece(move)])
+func PrintMove(move Move) {
+	WriteMove(os.Stdout, move)
+}
+
+// WriteMove writes a move in UCI notation to w, move.String() returns it
+func WriteMove(w io.Writer, move Move) {
+	fmt.Fprint(w, move)
 }
 
 // PrintMoveList prints the move list to the console
 func PrintMoveList(moveList *Moves) {
+	WriteMoveList(os.Stdout, moveList)
+}
+
+// MoveListString returns the table of the moves PrintMoveList prints
+func MoveListString(moveList *Moves) string {
+	var table strings.Builder
+	WriteMoveList(&table, moveList)
+	return table.String()
+}
+
+// WriteMoveList writes a table of the moves of the move list to w
+func WriteMoveList(w io.Writer, moveList *Moves) {
 	if moveList.Count == 0 {
-		fmt.Printf("\n\tNo moves available\n")
+		fmt.Fprintf(w, "\n\tNo moves available\n")
 		return
 	}
-	fmt.Printf("\n\tmove	piece	capture	doublePawnPush	enPassant\n")
+	fmt.Fprintf(w, "\n\tmove	piece	capture	doublePawnPush	enPassant\n")
 	for index := 0; index < moveList.Count; index++ {
 		move := moveList.Moves[index]
 		captured := ""
-		if GetMoveCapturedPiece(move) <= globals.BlackPawn && GetMoveCapturedPiece(move) >= globals.WhitePawn {
-			captured = globals.UnicodePieces[GetMoveCapturedPiece(move)]
+		if move.IsCapture() {
+			captured = globals.UnicodePieces[move.Captured()]
 		} else {
 			captured = "-"
 		}
-		fmt.Printf("\t")
-		PrintMove(move)
-		fmt.Printf("\t%s\t\t%s\t\t%d\t\t\t\t%d\n", globals.UnicodePieces[GetMovePiece(move)], captured, GetMoveDoublePawnPush(move), GetMoveEnPassant(move))
+		fmt.Fprintf(w, "\t")
+		WriteMove(w, move)
+		fmt.Fprintf(w, "\t%s\t\t%s\t\t%t\t\t\t%t\n", globals.UnicodePieces[move.Piece()], captured, move.IsDoublePawnPush(), move.IsEnPassant())
 	}
-	fmt.Printf("\n\tTotal moves: %d\n", moveList.Count)
+	fmt.Fprintf(w, "\n\tTotal moves: %d\n", moveList.Count)
 }
 
 // EncodeMove encodes a move into a 64-bit unsigned integer
-func EncodeMove(source int, target int, piece int, promotedPiece int, capturedPiece int, doublePawnPush int, enPassant int) uint64 {
+func EncodeMove(source globals.Square, target globals.Square, piece globals.Piece, promotedPiece globals.Piece, capturedPiece globals.Piece, doublePawnPush int, enPassant int) Move {
 	/*
 	   These are the move elements that we need to encode in a binary representation:
 
@@ -72,399 +95,596 @@ func EncodeMove(source int, target int, piece int, promotedPiece int, capturedPi
 	   0010 0000 0000 0000 0000 0000		double pawn push flag (1 bit)	0x200000
 	   0100 0000 0000 0000 0000 0000		en passant flag (1 bit)			0x400000
 	*/
-	return uint64(source | target<<6 | piece<<12 | promotedPiece<<15 | capturedPiece<<18 | doublePawnPush<<21 | enPassant<<22)
+	return Move(int(source) | int(target)<<6 | int(piece)<<12 | int(promotedPiece)<<15 | int(capturedPiece)<<18 | doublePawnPush<<21 | enPassant<<22)
 }
 
 // DecodeMove decodes a move and prints it to the console
-func DecodeMove(move uint64) {
-	fmt.Printf("%s ", globals.UnicodePieces[GetMovePiece(move)])
-	fmt.Printf("%s", globals.SquareToCoord[GetMoveSource(move)])
-	fmt.Printf("%s", globals.SquareToCoord[GetMoveTarget(move)])
-	if GetMovePromotedPiece(move) != 0 {
-		fmt.Printf("%s ", globals.UnicodePieces[GetMovePromotedPiece(move)])
+func DecodeMove(move Move) {
+	WriteDecodedMove(os.Stdout, move)
+}
+
+// DecodedMoveString returns the decoded move DecodeMove prints
+func DecodedMoveString(move Move) string {
+	var decoded strings.Builder
+	WriteDecodedMove(&decoded, move)
+	return decoded.String()
+}
+
+// WriteDecodedMove writes the piece, squares, promotion and capture of the move to w
+func WriteDecodedMove(w io.Writer, move Move) {
+	fmt.Fprintf(w, "%s ", globals.UnicodePieces[move.Piece()])
+	fmt.Fprintf(w, "%s", move.Source())
+	fmt.Fprintf(w, "%s", move.Target())
+	if move.IsPromotion() {
+		fmt.Fprintf(w, "%s ", globals.UnicodePieces[move.Promoted()])
 	}
-	if GetMoveCapturedPiece(move) <= globals.BlackPawn {
-		fmt.Printf(" x %s\n", globals.UnicodePieces[GetMoveCapturedPiece(move)])
+	if move.IsCapture() {
+		fmt.Fprintf(w, " x %s\n", globals.UnicodePieces[move.Captured()])
 	}
 }
 
-// GetMoveSource returns the source square of the move
-func GetMoveSource(move uint64) int {
-	return int(move & 0x3f)
+// Source returns the source square of the move
+func (move Move) Source() globals.Square {
+	return globals.Square(move & 0x3f)
+}
+
+// Target returns the target square of the move
+func (move Move) Target() globals.Square {
+	return globals.Square((move & 0xfc0) >> 6)
 }
 
-// GetMoveTarget returns the target square of the move
-func GetMoveTarget(move uint64) int {
-	return int((move & 0xfc0) >> 6)
+// Piece returns the piece type of the move
+func (move Move) Piece() globals.Piece {
+	return globals.Piece((move & 0x7000) >> 12)
 }
 
-// GetMovePiece returns the piece type of the move
-func GetMovePiece(move uint64) int {
-	return int((move & 0x7000) >> 12)
+// Promoted returns the promoted piece of the move, NoPiece if the move is not a promotion
+func (move Move) Promoted() globals.Piece {
+	return globals.Piece((move & 0x38000) >> 15)
 }
 
-// GetMovePromotedPiece returns the promoted piece of the move
-func GetMovePromotedPiece(move uint64) int {
-	return int((move & 0x38000) >> 15)
+// Captured returns the captured piece of the move, NoPiece if the move is not a capture
+func (move Move) Captured() globals.Piece {
+	return globals.Piece((move & 0x1C0000) >> 18)
 }
 
-// GetMoveCapturedPiece returns the captured piece of the move
-func GetMoveCapturedPiece(move uint64) int {
-	return int((move & 0x1C0000) >> 18)
+// IsDoublePawnPush returns true if the move is a double pawn push
+func (move Move) IsDoublePawnPush() bool {
+	return move&0x200000 != 0
 }
 
-// GetMoveDoublePawnPush returns the double pawn push flag of the move
-func GetMoveDoublePawnPush(move uint64) int {
-	return int((move & 0x200000) >> 21)
+// IsEnPassant returns true if the move is an en passant capture
+func (move Move) IsEnPassant() bool {
+	return move&0x400000 != 0
 }
 
-// GetMoveEnPassant returns the en passant flag of the move
-func GetMoveEnPassant(move uint64) int {
-	return int((move & 0x400000) >> 22)
+// IsCapture returns true if the move captures a piece
+func (move Move) IsCapture() bool {
+	return move.Captured() >= globals.WhitePawn && move.Captured() <= globals.BlackPawn
+}
+
+// IsPromotion returns true if the move promotes a pawn
+func (move Move) IsPromotion() bool {
+	return move.Promoted() >= globals.WhiteKnight && move.Promoted() <= globals.WhiteKing
+}
+
+// IsQuiet returns true if the move neither captures nor promotes
+func (move Move) IsQuiet() bool {
+	return !move.IsCapture() && !move.IsPromotion()
+}
+
+// IsIrreversible returns true if the move is a pawn move or a capture, no earlier position can occur again after it
+func (move Move) IsIrreversible() bool {
+	return move.Piece() == globals.WhitePawn || move.Piece() == globals.BlackPawn || move.IsCapture()
+}
+
+// String returns the move in UCI notation, for example "a2a4" or "b7b8N"
+func (move Move) String() string {
+	if move.IsPromotion() {
+		return move.Source().String() + move.Target().String() + move.Promoted().String()
+	}
+	return move.Source().String() + move.Target().String()
 }
 
 // GenerateMoves generates all the possible moves for the current board state
-func GenerateMoves(moveList *Moves) {
-	var sourceSquare, targetSquare int
-	var bitboard, attacks uint64
+func (pos *Position) GenerateMoves(moveList *Moves) {
+	pos.generateMoves(moveList, true, true)
+}
+
+// GenerateCaptures generates the captures and the promotions for the current board state
+func (pos *Position) GenerateCaptures(moveList *Moves) {
+	pos.generateMoves(moveList, true, false)
+}
+
+// GenerateQuietMoves generates the moves that neither capture nor promote for the current board state
+func (pos *Position) GenerateQuietMoves(moveList *Moves) {
+	pos.generateMoves(moveList, false, true)
+}
+
+// generateMoves generates the captures and promotions, the quiet moves or both for the current board state
+func (pos *Position) generateMoves(moveList *Moves, captures bool, quiets bool) {
+	var sourceSquare, targetSquare globals.Square
+	var bitboard, attacks globals.Bitboard
 	moveList.Count = 0
+	// the squares the pieces may move to
+	targets := ^pos.Occupancies[pos.SideToMove] & globals.BoardMask
+	if !captures {
+		targets &= ^pos.Occupancies[pos.SideToMove^1]
+	}
+	if !quiets {
+		targets &= pos.Occupancies[pos.SideToMove^1]
+	}
 
 	for piece := globals.WhitePawn; piece <= globals.BlackPawn; piece++ {
-		bitboard = globals.Bitboards[piece]
-		if globals.SideToMove == globals.WHITE {
+		bitboard = pos.Bitboards[piece]
+		if pos.SideToMove == globals.WHITE {
 			// generate moves for white pawns
 			if piece == globals.WhitePawn {
-				for bitboard != 0 {
-					sourceSquare = bitoperations.GetLeastSignificantBitIndex(bitboard)
-					targetSquare = sourceSquare - 5
-					// generate quiet pawn moves
-					if !(targetSquare < globals.A8) && bitoperations.GetBit(globals.Occupancies[globals.BOTH], targetSquare) == 0 {
-						// pawn promotion territory
-						if sourceSquare >= globals.A7 && sourceSquare <= globals.E7 {
-							for i := globals.WhiteKnight; i <= globals.WhiteKing; i++ {
-								moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, i, globals.NoPiece, 0, 0))
-							}
-						} else { // pawn move
-							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
-							if sourceSquare >= globals.A2 && sourceSquare <= globals.E2 && bitoperations.GetBit(globals.Occupancies[globals.BOTH], targetSquare-5) == 0 {
-								moveList.AddMove(EncodeMove(sourceSquare, targetSquare-5, piece, globals.NoPiece, globals.NoPiece, 1, 0))
-							}
-						}
-					}
-					attacks = globals.PawnAttacks[globals.SideToMove][sourceSquare] & globals.Occupancies[globals.BLACK]
-					// generate pawn captures
-					for attacks != 0 {
-						targetSquare = bitoperations.GetLeastSignificantBitIndex(attacks)
-						if sourceSquare >= globals.A7 && sourceSquare <= globals.E7 {
-							for i := globals.WhiteKnight; i <= globals.WhiteKing; i++ {
-								moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, i, globals.BlackPawn, 0, 0))
-							}
-						} else {
-							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.BlackPawn, 0, 0))
-						}
-						bitoperations.PopBit(&attacks, targetSquare)
-					}
-					// pop the least significant bit in the bitboard
-					bitoperations.PopBit(&bitboard, sourceSquare)
-				}
+				pos.generateWhitePawnMoves(moveList, captures, quiets)
 			} else if piece == globals.WhiteKnight {
 				for bitboard != 0 {
-					sourceSquare = bitoperations.GetLeastSignificantBitIndex(bitboard)
-					attacks = globals.KnightAttacks[sourceSquare] & ^globals.Occupancies[globals.WHITE]
+					sourceSquare = bitoperations.PopLSB(&bitboard)
+					attacks = globals.KnightAttacks[sourceSquare] & targets
 					for attacks != 0 {
-						targetSquare = bitoperations.GetLeastSignificantBitIndex(attacks)
+						targetSquare = bitoperations.PopLSB(&attacks)
 						// knight quiet move
-						if bitoperations.GetBit(globals.Occupancies[globals.BLACK], targetSquare) == 0 {
+						if bitoperations.GetBit(pos.Occupancies[globals.BLACK], targetSquare) == 0 {
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
 						} else {
 							// knight capture
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.BlackPawn, 0, 0))
 						}
-						bitoperations.PopBit(&attacks, targetSquare)
 					}
-					bitoperations.PopBit(&bitboard, sourceSquare)
 				}
 			} else if piece == globals.WhiteKing {
 				for bitboard != 0 {
-					sourceSquare = bitoperations.GetLeastSignificantBitIndex(bitboard)
-					attacks = globals.KingAttacks[sourceSquare] & ^globals.Occupancies[globals.WHITE]
+					sourceSquare = bitoperations.PopLSB(&bitboard)
+					attacks = globals.KingAttacks[sourceSquare] & targets
 					for attacks != 0 {
-						targetSquare = bitoperations.GetLeastSignificantBitIndex(attacks)
+						targetSquare = bitoperations.PopLSB(&attacks)
 						// king quiet move
-						if bitoperations.GetBit(globals.Occupancies[globals.BLACK], targetSquare) == 0 {
+						if bitoperations.GetBit(pos.Occupancies[globals.BLACK], targetSquare) == 0 {
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
 						} else {
 							// king capture
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.BlackPawn, 0, 0))
 						}
-						bitoperations.PopBit(&attacks, targetSquare)
 					}
-					bitoperations.PopBit(&bitboard, sourceSquare)
 				}
 			} else if piece == globals.WhiteBishop {
 				for bitboard != 0 {
-					sourceSquare = bitoperations.GetLeastSignificantBitIndex(bitboard)
-					attacks = GetBishopAttacks(sourceSquare, globals.Occupancies[globals.BOTH]) & ^globals.Occupancies[globals.WHITE]
+					sourceSquare = bitoperations.PopLSB(&bitboard)
+					attacks = GetBishopAttacks(sourceSquare, pos.Occupancies[globals.BOTH]) & targets
 					for attacks != 0 {
-						targetSquare = bitoperations.GetLeastSignificantBitIndex(attacks)
-						// knight quiet move
-						if bitoperations.GetBit(globals.Occupancies[globals.BLACK], targetSquare) == 0 {
+						targetSquare = bitoperations.PopLSB(&attacks)
+						// bishop quiet move
+						if bitoperations.GetBit(pos.Occupancies[globals.BLACK], targetSquare) == 0 {
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
 						} else {
-							// knight capture
+							// bishop capture
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.BlackPawn, 0, 0))
 						}
-						bitoperations.PopBit(&attacks, targetSquare)
 					}
-					bitoperations.PopBit(&bitboard, sourceSquare)
 				}
 			} else if piece == globals.WhiteRook {
 				for bitboard != 0 {
-					sourceSquare = bitoperations.GetLeastSignificantBitIndex(bitboard)
-					attacks = GetRookAttacks(sourceSquare, globals.Occupancies[globals.BOTH]) & ^globals.Occupancies[globals.WHITE]
+					sourceSquare = bitoperations.PopLSB(&bitboard)
+					attacks = GetRookAttacks(sourceSquare, pos.Occupancies[globals.BOTH]) & targets
 					for attacks != 0 {
-						targetSquare = bitoperations.GetLeastSignificantBitIndex(attacks)
-						// knight quiet move
-						if bitoperations.GetBit(globals.Occupancies[globals.BLACK], targetSquare) == 0 {
+						targetSquare = bitoperations.PopLSB(&attacks)
+						// rook quiet move
+						if bitoperations.GetBit(pos.Occupancies[globals.BLACK], targetSquare) == 0 {
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
 						} else {
-							// knight capture
+							// rook capture
 							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.BlackPawn, 0, 0))
 						}
-						bitoperations.PopBit(&attacks, targetSquare)
 					}
-					bitoperations.PopBit(&bitboard, sourceSquare)
 				}
-			}
-		} else {
-			// generate moves for black pawns
-			if piece == globals.BlackPawn {
+			} else if piece == globals.WhiteQueen {
 				for bitboard != 0 {
-					sourceSquare = bitoperations.GetLeastSignificantBitIndex(bitboard)
-					targetSquare = sourceSquare + 5
-					// generate quiet pawn moves
-					if !(targetSquare > globals.E1) && bitoperations.GetBit(globals.Occupancies[globals.BOTH], targetSquare) == 0 {
-						// pawn move
-						moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
-					}
-					attacks = globals.PawnAttacks[globals.SideToMove][sourceSquare] & globals.Occupancies[globals.WHITE]
-					// generate pawn captures
+					sourceSquare = bitoperations.PopLSB(&bitboard)
+					attacks = GetQueenAttacks(sourceSquare, pos.Occupancies[globals.BOTH]) & targets
 					for attacks != 0 {
-						targetSquare = bitoperations.GetLeastSignificantBitIndex(attacks)
-						capturedPiece := 7 // initialize to an invalid piece
-						// loop over the opposite sides pieces
-						for p := globals.WhitePawn; p <= globals.WhiteKing; p++ {
-							if bitoperations.GetBit(globals.Bitboards[p], targetSquare) == 1 {
-								capturedPiece = p
-								break
-							}
-						}
-						moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, capturedPiece, 0, 0))
-						bitoperations.PopBit(&attacks, targetSquare)
-					}
-					if globals.EnPassantSquare != globals.NoSquare {
-						enPassantAttacks := globals.PawnAttacks[globals.SideToMove][sourceSquare] & (1 << globals.EnPassantSquare)
-						if enPassantAttacks != 0 && ((1<<(globals.EnPassantSquare-5))&globals.Bitboards[globals.WhitePawn]) != 0 {
-							targetEnPassantSquare := bitoperations.GetLeastSignificantBitIndex(enPassantAttacks)
-							moveList.AddMove(EncodeMove(sourceSquare, targetEnPassantSquare, piece, globals.NoPiece, globals.WhitePawn, 0, 1))
+						targetSquare = bitoperations.PopLSB(&attacks)
+						// queen quiet move
+						if bitoperations.GetBit(pos.Occupancies[globals.BLACK], targetSquare) == 0 {
+							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0))
+						} else {
+							// queen capture
+							moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.BlackPawn, 0, 0))
 						}
 					}
-					// pop the least significant bit in the bitboard
-					bitoperations.PopBit(&bitboard, sourceSquare)
 				}
 			}
+		} else {
+			// generate moves for black pawns
+			if piece == globals.BlackPawn {
+				pos.generateBlackPawnMoves(moveList, captures, quiets)
+			}
+		}
+	}
+}
+
+// generateWhitePawnMoves generates the moves of all white pawns at once by shifting the pawn bitboard one row up.
+// Captures and promotions are generated when captures is set, the other pushes when quiets is set
+func (pos *Position) generateWhitePawnMoves(moveList *Moves, captures bool, quiets bool) {
+	files := globals.Square(globals.Geometry.Files)
+	pawns := pos.Bitboards[globals.WhitePawn]
+	empty := ^pos.Occupancies[globals.BOTH] & globals.BoardMask
+	singlePushes := bitoperations.North(pawns) & empty
+	promotions := singlePushes & globals.RowMasks[0]
+	if captures {
+		// the shift helpers drop the captures that would wrap around to the other side of the board
+		leftCaptures := bitoperations.NorthWest(pawns) & pos.Occupancies[globals.BLACK]
+		rightCaptures := bitoperations.NorthEast(pawns) & pos.Occupancies[globals.BLACK]
+		pos.addPawnMoves(moveList, globals.WhitePawn, promotions, files, 0)
+		pos.addPawnMoves(moveList, globals.WhitePawn, leftCaptures, files+1, 0)
+		pos.addPawnMoves(moveList, globals.WhitePawn, rightCaptures, files-1, 0)
+	}
+	if quiets {
+		// pawns on the second row from the bottom may push twice, their single pushes land on the third row
+		doublePushes := bitoperations.North(singlePushes&globals.RowMasks[globals.Geometry.Ranks-3]) & empty
+		pos.addPawnMoves(moveList, globals.WhitePawn, singlePushes&^promotions, files, 0)
+		pos.addPawnMoves(moveList, globals.WhitePawn, doublePushes, 2*files, 1)
+	}
+}
+
+// generateBlackPawnMoves generates the moves of all black pawns at once by shifting the pawn bitboard one row down.
+// Captures are generated when captures is set, pushes when quiets is set
+func (pos *Position) generateBlackPawnMoves(moveList *Moves, captures bool, quiets bool) {
+	files := globals.Square(globals.Geometry.Files)
+	pawns := pos.Bitboards[globals.BlackPawn]
+	if quiets {
+		empty := ^pos.Occupancies[globals.BOTH] & globals.BoardMask
+		singlePushes := bitoperations.South(pawns) & empty
+		pos.addPawnMoves(moveList, globals.BlackPawn, singlePushes, -files, 0)
+		if ActiveVariant.BlackDoubleSteps {
+			// pawns on the front start rank may step twice, their single pushes land on the fourth row
+			doublePushes := bitoperations.South(singlePushes&globals.RowMasks[3]) & empty
+			pos.addPawnMoves(moveList, globals.BlackPawn, doublePushes, -2*files, 1)
+		}
+	}
+	if !captures {
+		return
+	}
+	// the shift helpers drop the captures that would wrap around to the other side of the board
+	leftCaptures := bitoperations.SouthWest(pawns) & pos.Occupancies[globals.WHITE]
+	rightCaptures := bitoperations.SouthEast(pawns) & pos.Occupancies[globals.WHITE]
+	pos.addPawnMoves(moveList, globals.BlackPawn, leftCaptures, -(files - 1), 0)
+	pos.addPawnMoves(moveList, globals.BlackPawn, rightCaptures, -(files + 1), 0)
+
+	// en passant captures the white pawn that double pushed past the en passant square
+	// the square in front of it is looked up only when it is on the board
+	if pos.EnPassantSquare != globals.NoSquare && pos.EnPassantSquare >= files &&
+		pos.PieceAt(pos.EnPassantSquare-files) == globals.WhitePawn {
+		// the black pawns attacking the en passant square stand where a white pawn on it would attack
+		attackers := globals.PawnAttacks[globals.WHITE][pos.EnPassantSquare] & pawns
+		for attackers != 0 {
+			sourceSquare := bitoperations.PopLSB(&attackers)
+			moveList.AddMove(EncodeMove(sourceSquare, pos.EnPassantSquare, globals.BlackPawn, globals.NoPiece, globals.WhitePawn, 0, 1))
+		}
+	}
+}
+
+// addPawnMoves adds a pawn move to each square of the target set, the source square of every move lies offset squares
+// after its target. Moves onto the top row are white promotions and are added once for each promotion piece of the
+// active variant
+func (pos *Position) addPawnMoves(moveList *Moves, piece globals.Piece, targets globals.Bitboard, offset globals.Square, doublePawnPush int) {
+	for targets != 0 {
+		targetSquare := bitoperations.PopLSB(&targets)
+		sourceSquare := targetSquare + offset
+		capturedPiece := pos.PieceAt(targetSquare)
+		if piece == globals.WhitePawn && globals.Geometry.Rank(targetSquare) == 0 {
+			for _, promotedPiece := range ActiveVariant.Promotions {
+				moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, promotedPiece, capturedPiece, 0, 0))
+			}
+		} else {
+			moveList.AddMove(EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, capturedPiece, doublePawnPush, 0))
+		}
+	}
+}
+
+// IsPseudoLegal returns true if the move could have been generated in the current position. It is used to check
+// moves that do not come from the move generator of this position, such as hash moves and killer moves
+func (pos *Position) IsPseudoLegal(move Move) bool {
+	sourceSquare, targetSquare, piece := move.Source(), move.Target(), move.Piece()
+	if move == NoMove || sourceSquare >= globals.Geometry.Squares() || targetSquare >= globals.Geometry.Squares() {
+		return false
+	}
+	if piece >= globals.NoPiece || pos.PieceAt(sourceSquare) != piece || piece.Color() != pos.SideToMove {
+		return false
+	}
+	files := globals.Square(globals.Geometry.Files)
+	capturedPiece := pos.PieceAt(targetSquare)
+	if capturedPiece != globals.NoPiece && capturedPiece.Color() == pos.SideToMove {
+		return false
+	}
+	pawnAttack := globals.PawnAttacks[pos.SideToMove][sourceSquare]&targetSquare.Bit() != 0
+	// build the move the generator would encode for the same squares and compare it with the given move
+	var expected Move
+	switch piece {
+	case globals.WhitePawn:
+		promotedPiece := globals.NoPiece
+		if globals.Geometry.Rank(targetSquare) == 0 {
+			if !move.IsPromotion() || !ActiveVariant.CanPromoteTo(move.Promoted()) {
+				return false
+			}
+			promotedPiece = move.Promoted()
+		}
+		if targetSquare == sourceSquare-files && capturedPiece == globals.NoPiece {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, promotedPiece, globals.NoPiece, 0, 0)
+		} else if targetSquare == sourceSquare-2*files && globals.Geometry.Rank(sourceSquare) == globals.Geometry.Ranks-2 &&
+			capturedPiece == globals.NoPiece && pos.PieceAt(sourceSquare-files) == globals.NoPiece {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 1, 0)
+		} else if pawnAttack && capturedPiece != globals.NoPiece {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, promotedPiece, capturedPiece, 0, 0)
+		} else {
+			return false
+		}
+	case globals.BlackPawn:
+		if targetSquare == sourceSquare+files && capturedPiece == globals.NoPiece {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 0, 0)
+		} else if targetSquare == sourceSquare+2*files && ActiveVariant.BlackDoubleSteps && globals.Geometry.Rank(sourceSquare) == 2 &&
+			capturedPiece == globals.NoPiece && pos.PieceAt(sourceSquare+files) == globals.NoPiece {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.NoPiece, 1, 0)
+		} else if pawnAttack && capturedPiece != globals.NoPiece {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, capturedPiece, 0, 0)
+		} else if pawnAttack && targetSquare == pos.EnPassantSquare && targetSquare >= files &&
+			pos.PieceAt(targetSquare-files) == globals.WhitePawn {
+			expected = EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, globals.WhitePawn, 0, 1)
+		} else {
+			return false
+		}
+	default:
+		var attacks globals.Bitboard
+		switch piece {
+		case globals.WhiteKnight:
+			attacks = globals.KnightAttacks[sourceSquare]
+		case globals.WhiteBishop:
+			attacks = GetBishopAttacks(sourceSquare, pos.Occupancies[globals.BOTH])
+		case globals.WhiteRook:
+			attacks = GetRookAttacks(sourceSquare, pos.Occupancies[globals.BOTH])
+		case globals.WhiteQueen:
+			attacks = GetQueenAttacks(sourceSquare, pos.Occupancies[globals.BOTH])
+		case globals.WhiteKing:
+			attacks = globals.KingAttacks[sourceSquare]
 		}
+		if attacks&targetSquare.Bit() == 0 {
+			return false
+		}
+		expected = EncodeMove(sourceSquare, targetSquare, piece, globals.NoPiece, capturedPiece, 0, 0)
 	}
+	return move == expected
 }
 
-func MakeMove(move uint64, moveFlag int) int {
+// MakeMove plays the move on the board, returning 0 if the move was not made
+func (pos *Position) MakeMove(move Move, moveFlag int) int {
 	// quiet moves
 	if moveFlag == globals.AllMoves {
-		// preserve the current state for undoing moves
-		sourceSquare := GetMoveSource(move)
-		targetSquare := GetMoveTarget(move)
-		piece := GetMovePiece(move)
-		promotedPiece := GetMovePromotedPiece(move)
-		doublePawnPush := GetMoveDoublePawnPush(move)
-		capturedPiece := GetMoveCapturedPiece(move)
-		enPassant := GetMoveEnPassant(move)
-		// record the move in the stack
-		MoveStack = append(MoveStack, MoveRecord{move, globals.EnPassantSquare, globals.SideToMove, globals.Occupancies, globals.HashKey})
+		sourceSquare := move.Source()
+		targetSquare := move.Target()
+		piece := move.Piece()
+		capturedPiece := move.Captured()
+		side, opponent := pos.SideToMove, pos.SideToMove^1
+		// a royal king is never captured
+		if capturedPiece == globals.WhiteKing && !ActiveVariant.KingCapturable {
+			return 0
+		}
+		// preserve the current state for undoing moves, the game history keeps growing as needed
+		pos.MoveStack = append(pos.MoveStack[:pos.MoveCount],
+			MoveRecord{move, pos.EnPassantSquare, pos.HashKey, pos.IrreversibleIndex, pos.HalfmoveClock})
+		pos.MoveCount++
+		if move.IsIrreversible() {
+			pos.IrreversibleIndex = pos.MoveCount
+			pos.HalfmoveClock = 0
+		} else {
+			pos.HalfmoveClock++
+		}
 
 		// move the piece
-		bitoperations.PopBit(&globals.Bitboards[piece], sourceSquare)
-		bitoperations.SetBit(&globals.Bitboards[piece], targetSquare)
+		bitoperations.PopBit(&pos.Bitboards[piece], sourceSquare)
+		bitoperations.SetBit(&pos.Bitboards[piece], targetSquare)
+		pos.Occupancies[side] ^= sourceSquare.Bit() | targetSquare.Bit()
+		pos.Mailbox[sourceSquare] = globals.NoPiece
+		pos.Mailbox[targetSquare] = piece
 
 		// update the hash key
-		globals.HashKey ^= PieceKeys[piece][sourceSquare] // remove piece from source square
-		globals.HashKey ^= PieceKeys[piece][targetSquare] // add piece to target square
-
-		//if there is a captured piece, remove it from the board
-		if capturedPiece != globals.NoPiece {
-			if bitoperations.GetBit(globals.Bitboards[capturedPiece], targetSquare) == 1 {
-				bitoperations.PopBit(&globals.Bitboards[capturedPiece], targetSquare)
-				// remove captured piece from hash key
-				globals.HashKey ^= PieceKeys[capturedPiece][targetSquare]
-			}
+		pos.HashKey ^= PieceKeys[piece][sourceSquare] // remove piece from source square
+		pos.HashKey ^= PieceKeys[piece][targetSquare] // add piece to target square
+
+		if move.IsEnPassant() {
+			// if there is an en passant capture, remove the pawn behind the target square from the board
+			capturedSquare := targetSquare - globals.Square(globals.Geometry.Files)
+			bitoperations.PopBit(&pos.Bitboards[capturedPiece], capturedSquare)
+			pos.Occupancies[opponent] ^= capturedSquare.Bit()
+			pos.Mailbox[capturedSquare] = globals.NoPiece
+			pos.HashKey ^= PieceKeys[capturedPiece][capturedSquare]
+		} else if capturedPiece != globals.NoPiece {
+			// if there is a captured piece, remove it from the board
+			bitoperations.PopBit(&pos.Bitboards[capturedPiece], targetSquare)
+			pos.Occupancies[opponent] ^= targetSquare.Bit()
+			// remove captured piece from hash key
+			pos.HashKey ^= PieceKeys[capturedPiece][targetSquare]
 		}
 		// if there is a promotion, remove the piece from the board and add the promoted piece
-		if promotedPiece <= globals.WhiteKing && promotedPiece >= globals.WhiteKnight {
-			bitoperations.PopBit(&globals.Bitboards[piece], targetSquare)
-			globals.HashKey ^= PieceKeys[piece][targetSquare]
-			bitoperations.SetBit(&globals.Bitboards[promotedPiece], targetSquare)
-			globals.HashKey ^= PieceKeys[promotedPiece][targetSquare]
-		}
-		// if there is an en passant capture, remove the pawn from the board
-		if enPassant != 0 {
-			// only black side can perform en passant capture
-			if globals.SideToMove == globals.BLACK {
-				bitoperations.PopBit(&globals.Bitboards[globals.WhitePawn], targetSquare-5)
-				globals.HashKey ^= PieceKeys[globals.WhitePawn][targetSquare-5]
-			}
+		if move.IsPromotion() {
+			promotedPiece := move.Promoted()
+			bitoperations.PopBit(&pos.Bitboards[piece], targetSquare)
+			pos.HashKey ^= PieceKeys[piece][targetSquare]
+			bitoperations.SetBit(&pos.Bitboards[promotedPiece], targetSquare)
+			pos.HashKey ^= PieceKeys[promotedPiece][targetSquare]
+			pos.Mailbox[targetSquare] = promotedPiece
 		}
 		// hash en passant if available (remove enpassant square from hash key)
-		if globals.EnPassantSquare != globals.NoSquare {
-			globals.HashKey ^= EnPassantKeys[globals.EnPassantSquare]
+		if pos.EnPassantSquare != globals.NoSquare {
+			pos.HashKey ^= EnPassantKeys[pos.EnPassantSquare]
 		}
-		globals.EnPassantSquare = globals.NoSquare
-		if doublePawnPush != 0 && globals.SideToMove == globals.WHITE {
-			globals.EnPassantSquare = targetSquare + 5
+		pos.EnPassantSquare = globals.NoSquare
+		if move.IsDoublePawnPush() && side == globals.WHITE {
+			pos.EnPassantSquare = targetSquare + globals.Square(globals.Geometry.Files)
 			// hash the en passant square
-			globals.HashKey ^= EnPassantKeys[targetSquare+5]
+			pos.HashKey ^= EnPassantKeys[pos.EnPassantSquare]
+		}
+		pos.Occupancies[globals.BOTH] = pos.Occupancies[globals.WHITE] | pos.Occupancies[globals.BLACK]
+		pos.SideToMove = opponent
+		pos.HashKey ^= SideKey // hash the side
+		if side == globals.BLACK && pos.FullmoveNumber != 0 {
+			pos.FullmoveNumber++
 		}
-		// update occupancy bitboards
-		globals.Occupancies = [3]uint64{0, 0, 0}
-		for i := globals.WhitePawn; i <= globals.WhiteKing; i++ {
-			globals.Occupancies[globals.WHITE] |= globals.Bitboards[i]
+		pos.debugCheck("MakeMove", move)
+		// white may not leave a royal king attacked
+		if side == globals.WHITE && pos.IsInCheck() {
+			pos.UnMakeMove()
+			return 0
 		}
-		globals.Occupancies[globals.BLACK] |= globals.Bitboards[globals.BlackPawn]
-		globals.Occupancies[globals.BOTH] |= globals.Occupancies[globals.WHITE] | globals.Occupancies[globals.BLACK]
-		globals.SideToMove ^= 1
-		globals.HashKey ^= SideKey // hash the side
-
-		//// debugging for hash keys
-		//hasFromScratch := GeneratePositionKey()
-		//// If the hash keys do not match the incremental hash, interrupt execution
-		//if hasFromScratch != globals.HashKey {
-		//	fmt.Printf("\n\nMake Move!\n")
-		//	fmt.Printf("move: ")
-		//	PrintMove(move)
-		//	PrintBoard()
-		//	fmt.Printf("Hash keys should be: %x\n", hasFromScratch)
-		//	globals.Scanner.Scan()
-		//}
+
 		return 1 // move made successfully
 	} else {
 		// capture moves
-		capturedPiece := GetMoveCapturedPiece(move)
-		if capturedPiece <= globals.BlackPawn && capturedPiece >= globals.WhitePawn {
-			return MakeMove(move, globals.AllMoves)
+		if move.IsCapture() {
+			return pos.MakeMove(move, globals.AllMoves)
 		} else {
 			return 0
 		}
 	}
 }
 
-func UnMakeMove() {
+// UnMakeMove takes back the last move made on the board
+func (pos *Position) UnMakeMove() {
 	// pop the last move from the stack
-	rec := MoveStack[len(MoveStack)-1]
-	MoveStack = MoveStack[:len(MoveStack)-1]
+	pos.MoveCount--
+	rec := &pos.MoveStack[pos.MoveCount]
 	move := rec.move
-	capturedPiece := GetMoveCapturedPiece(move)
-	sourceSquare := GetMoveSource(move)
-	targetSquare := GetMoveTarget(move)
-	piece := GetMovePiece(move)
-	promotedPiece := GetMovePromotedPiece(move)
-	enPassant := GetMoveEnPassant(move)
+	sourceSquare := move.Source()
+	targetSquare := move.Target()
+	piece := move.Piece()
+	capturedPiece := move.Captured()
+	side, opponent := pos.SideToMove^1, pos.SideToMove
 
 	// restore game variables
-	globals.SideToMove = rec.sideToMove
-	globals.EnPassantSquare = rec.enPassantSquare
-	globals.Occupancies = rec.occupancies
-	globals.HashKey = rec.hashKey
+	pos.SideToMove = side
+	pos.EnPassantSquare = rec.enPassantSquare
+	pos.HashKey = rec.hashKey
+	pos.IrreversibleIndex = rec.irreversibleIndex
+	pos.HalfmoveClock = rec.halfmoveClock
+	if side == globals.BLACK && pos.FullmoveNumber != 0 {
+		pos.FullmoveNumber--
+	}
 
 	// undo promotion or normal move
-	if promotedPiece <= globals.WhiteKing && promotedPiece >= globals.WhiteKnight {
-		bitoperations.PopBit(&globals.Bitboards[promotedPiece], targetSquare)
-		bitoperations.SetBit(&globals.Bitboards[piece], sourceSquare)
+	if move.IsPromotion() {
+		bitoperations.PopBit(&pos.Bitboards[move.Promoted()], targetSquare)
 	} else {
-		bitoperations.PopBit(&globals.Bitboards[piece], targetSquare)
-		bitoperations.SetBit(&globals.Bitboards[piece], sourceSquare)
+		bitoperations.PopBit(&pos.Bitboards[piece], targetSquare)
 	}
+	bitoperations.SetBit(&pos.Bitboards[piece], sourceSquare)
+	pos.Occupancies[side] ^= sourceSquare.Bit() | targetSquare.Bit()
+	pos.Mailbox[sourceSquare] = piece
+	pos.Mailbox[targetSquare] = globals.NoPiece
 
-	// restore captured piece (normal capture)
-	if capturedPiece != globals.NoPiece {
-		// For en passant, restore pawn on correct square
-		if enPassant != 0 {
-			// only black side can perform en passant capture
-			if globals.SideToMove == globals.BLACK {
-				bitoperations.SetBit(&globals.Bitboards[globals.WhitePawn], targetSquare-5)
-			}
-		} else {
-			bitoperations.SetBit(&globals.Bitboards[capturedPiece], targetSquare)
-		}
+	// restore the captured piece, for en passant the pawn is restored behind the target square
+	if move.IsEnPassant() {
+		capturedSquare := targetSquare - globals.Square(globals.Geometry.Files)
+		bitoperations.SetBit(&pos.Bitboards[capturedPiece], capturedSquare)
+		pos.Occupancies[opponent] ^= capturedSquare.Bit()
+		pos.Mailbox[capturedSquare] = capturedPiece
+	} else if capturedPiece != globals.NoPiece {
+		bitoperations.SetBit(&pos.Bitboards[capturedPiece], targetSquare)
+		pos.Occupancies[opponent] ^= targetSquare.Bit()
+		pos.Mailbox[targetSquare] = capturedPiece
+	}
+	pos.Occupancies[globals.BOTH] = pos.Occupancies[globals.WHITE] | pos.Occupancies[globals.BLACK]
+	pos.debugCheck("UnMakeMove", move)
+}
+
+// MakeNullMove passes the turn to the opponent without moving a piece, used by the null move pruning
+func (pos *Position) MakeNullMove() {
+	pos.MoveStack = append(pos.MoveStack[:pos.MoveCount],
+		MoveRecord{NoMove, pos.EnPassantSquare, pos.HashKey, pos.IrreversibleIndex, pos.HalfmoveClock})
+	pos.MoveCount++
+	// the search must not find repetitions through a null move, which is not a move of the game
+	pos.IrreversibleIndex = pos.MoveCount
+	pos.HalfmoveClock++
+	// remove the en passant square, it is only available right after the double pawn push
+	if pos.EnPassantSquare != globals.NoSquare {
+		pos.HashKey ^= EnPassantKeys[pos.EnPassantSquare]
 	}
+	pos.EnPassantSquare = globals.NoSquare
+	pos.SideToMove ^= 1
+	pos.HashKey ^= SideKey
+	pos.debugCheck("MakeNullMove", NoMove)
+}
+
+// UnMakeNullMove takes back the null move made with MakeNullMove
+func (pos *Position) UnMakeNullMove() {
+	pos.MoveCount--
+	rec := &pos.MoveStack[pos.MoveCount]
+	pos.SideToMove ^= 1
+	pos.EnPassantSquare = rec.enPassantSquare
+	pos.HashKey = rec.hashKey
+	pos.IrreversibleIndex = rec.irreversibleIndex
+	pos.HalfmoveClock = rec.halfmoveClock
+	pos.debugCheck("UnMakeNullMove", NoMove)
+}
 
-	//hasFromScratch := GeneratePositionKey()
-	//// If the hash keys do not match the incremental hash, interrupt execution
-	//if hasFromScratch != globals.HashKey {
-	//	fmt.Printf("\n\nUnMake Move!\n")
-	//	fmt.Printf("move: ")
-	//	PrintMove(move)
-	//	PrintBoard()
-	//	fmt.Printf("Hash keys should be: %x\n", hasFromScratch)
-	//	globals.Scanner.Scan()
-	//}
-}
-
-// PerftDriver is a recursive procedure that counts the number of leaf nodes in the move tree up to the given depth
-func PerftDriver(depth int) {
-	globals.NodesVisited++
+// PerftDriver is a recursive procedure that walks the move tree up to the given depth and returns the number of
+// nodes visited and the number of leaf nodes among them. The counts are returned rather than kept in globals, so
+// several positions can be counted at once
+func (pos *Position) PerftDriver(depth int) (nodes int, leafNodes int) {
 	if depth <= 0 {
 		// count the nodes
-		globals.LeafNodesVisited++
-		return
+		return 1, 1
 	}
+	nodes = 1
 	moveList := Moves{}
-	GenerateMoves(&moveList)
+	pos.GenerateMoves(&moveList)
 	for i := 0; i < moveList.Count; i++ {
 		//b, o, s, e := CopyBoard()
-		if MakeMove(moveList.Moves[i], globals.AllMoves) == 0 {
+		if pos.MakeMove(moveList.Moves[i], globals.AllMoves) == 0 {
 			continue // skip to next move if it is illegal
 		}
-		PerftDriver(depth - 1)
+		childNodes, childLeafNodes := pos.PerftDriver(depth - 1)
+		nodes += childNodes
+		leafNodes += childLeafNodes
 		//RestoreBoard(b, o, s, e)
-		UnMakeMove()
+		pos.UnMakeMove()
 	}
+	return nodes, leafNodes
+}
+
+// PerftTest performs a performance test of the move generation and move making functions and prints the node counts
+// to the console
+func (pos *Position) PerftTest(depth int) {
+	pos.WritePerftTest(os.Stdout, depth)
 }
 
-// PerftTest performs a performance test of the move generation and move making functions
-func PerftTest(depth int) {
-	globals.NodesVisited = 0
-	globals.LeafNodesVisited = 0
-	fmt.Println("\t--- Performance test ---")
-	fmt.Println("\tMove \tNodes")
+// WritePerftTest performs the performance test of PerftTest and writes the node counts of every move to w
+func (pos *Position) WritePerftTest(w io.Writer, depth int) {
+	nodes, leafNodes := 0, 0
+	fmt.Fprintln(w, "\t--- Performance test ---")
+	fmt.Fprintln(w, "\tMove \tNodes")
 	moveList := Moves{}
-	GenerateMoves(&moveList)
+	pos.GenerateMoves(&moveList)
 	startTime := time.Now()
 	for i := 0; i < moveList.Count; i++ {
 		//b, o, s, e := CopyBoard()
-		fmt.Printf("\t")
-		PrintMove(moveList.Moves[i])
-		if MakeMove(moveList.Moves[i], globals.AllMoves) == 0 {
+		fmt.Fprintf(w, "\t")
+		WriteMove(w, moveList.Moves[i])
+		if pos.MakeMove(moveList.Moves[i], globals.AllMoves) == 0 {
 			continue // skip to next move if it is illegal
 		}
-		cumulativeNodes := globals.LeafNodesVisited
-		PerftDriver(depth - 1)
-		oldNodes := globals.LeafNodesVisited - cumulativeNodes
+		moveNodes, moveLeafNodes := pos.PerftDriver(depth - 1)
+		nodes += moveNodes
+		leafNodes += moveLeafNodes
 		//RestoreBoard(b, o, s, e)
-		UnMakeMove()
-		fmt.Printf("\t%d", oldNodes)
-		fmt.Println()
+		pos.UnMakeMove()
+		fmt.Fprintf(w, "\t%d", moveLeafNodes)
+		fmt.Fprintln(w)
 	}
 	elapsed := time.Since(startTime)
-	fmt.Printf("\tDepth: %d\n", depth)
-	fmt.Printf("\tLeaf Nodes: %d\n", globals.LeafNodesVisited)
-	fmt.Printf("\tTotal Nodes: %d\n", globals.NodesVisited)
-	fmt.Printf("\tTime: %s\n", elapsed)
+	fmt.Fprintf(w, "\tDepth: %d\n", depth)
+	fmt.Fprintf(w, "\tLeaf Nodes: %d\n", leafNodes)
+	fmt.Fprintf(w, "\tTotal Nodes: %d\n", nodes)
+	fmt.Fprintf(w, "\tTime: %s\n", elapsed)
 }
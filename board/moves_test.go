@@ -0,0 +1,74 @@
+package board
+
+import (
+	"testing"
+	"zerginator/globals"
+)
+
+// benchmarkFENs are the positions the move generation benchmarks start from
+var benchmarkFENs = []struct {
+	name string
+	fen  string
+}{
+	{"FenDebug2", globals.FenDebug2},
+	{"FenDebug3", globals.FenDebug3},
+	{"FenDebug4", globals.FenDebug4},
+}
+
+// treePositions returns a copy of every position of the move tree of the FEN up to the given depth
+func treePositions(b *testing.B, fen string, depth int) []Position {
+	pos := NewPosition()
+	if err := pos.ParseFEN(fen); err != nil {
+		b.Fatal(err)
+	}
+	var positions []Position
+	var walk func(depth int)
+	walk = func(depth int) {
+		positions = append(positions, *pos)
+		if depth <= 0 {
+			return
+		}
+		var moveList Moves
+		pos.GenerateMoves(&moveList)
+		for i := 0; i < moveList.Count; i++ {
+			if pos.MakeMove(moveList.Moves[i], globals.AllMoves) == 0 {
+				continue
+			}
+			walk(depth - 1)
+			pos.UnMakeMove()
+		}
+	}
+	walk(depth)
+	return positions
+}
+
+// BenchmarkGenerateMoves times GenerateMoves on the positions of the move tree up to depth 3, one generation per
+// iteration
+func BenchmarkGenerateMoves(b *testing.B) {
+	for _, bench := range benchmarkFENs {
+		b.Run(bench.name, func(b *testing.B) {
+			positions := treePositions(b, bench.fen, 3)
+			var moveList Moves
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				positions[i%len(positions)].GenerateMoves(&moveList)
+			}
+		})
+	}
+}
+
+// BenchmarkPerft times a perft 5, which makes and unmakes every move of the tree
+func BenchmarkPerft(b *testing.B) {
+	for _, bench := range benchmarkFENs {
+		b.Run(bench.name, func(b *testing.B) {
+			pos := NewPosition()
+			if err := pos.ParseFEN(bench.fen); err != nil {
+				b.Fatal(err)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				pos.PerftDriver(5)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !debug
+
+package board
+
+// debugCheck does nothing outside the debug build, see debug.go
+func (pos *Position) debugCheck(action string, move Move) {}
@@ -0,0 +1,173 @@
+package board
+
+import "zerginator/globals"
+
+/*
+	The rules for the end of the game live here and nowhere else. The search, the UCI loop and the GUI all ask the
+	position for its result, so they always agree on when a game is over and who won. The goal rank, the outcome
+	without legal moves and the royal king come from the active variant.
+*/
+
+// GameResult is the state of the game: still going on, won by one side, or drawn
+type GameResult int
+
+// Game results
+const (
+	Ongoing GameResult = iota
+	WhiteWins
+	BlackWins
+	Draw
+)
+
+// String returns the result as shown to the players, for example "white wins"
+func (r GameResult) String() string {
+	switch r {
+	case Ongoing:
+		return "ongoing"
+	case WhiteWins:
+		return "white wins"
+	case BlackWins:
+		return "black wins"
+	case Draw:
+		return "draw"
+	}
+	return "unknown result"
+}
+
+// ResultReason is the rule that ended the game
+type ResultReason int
+
+// Result reasons, NoReason goes with an ongoing game
+const (
+	NoReason ResultReason = iota
+	BlackPawnBreakthrough
+	WhitePiecesCaptured
+	BlackPawnsCaptured
+	NoLegalMoves
+	Checkmate
+	ThreefoldRepetition
+	NoProgress
+	DeadPosition
+	// TimeForfeit is never returned by Result, it is for the callers that run a game clock
+	TimeForfeit
+)
+
+// String returns the reason as shown to the players, for example "black pawn breakthrough"
+func (r ResultReason) String() string {
+	switch r {
+	case NoReason:
+		return "none"
+	case BlackPawnBreakthrough:
+		return "black pawn breakthrough"
+	case WhitePiecesCaptured:
+		return "all white pieces captured"
+	case BlackPawnsCaptured:
+		return "all black pawns captured"
+	case NoLegalMoves:
+		return "no legal moves"
+	case Checkmate:
+		return "checkmate"
+	case ThreefoldRepetition:
+		return "threefold repetition"
+	case NoProgress:
+		return "no progress"
+	case DeadPosition:
+		return "dead position"
+	case TimeForfeit:
+		return "time forfeit"
+	}
+	return "unknown reason"
+}
+
+// Result returns the result of the game in the current position and the rule that decided it
+func (pos *Position) Result() (GameResult, ResultReason) {
+	if result, reason := pos.TerminalResult(); result != Ongoing {
+		return result, reason
+	}
+	if pos.RepetitionCount() >= globals.RepetitionLimit {
+		return Draw, ThreefoldRepetition
+	}
+	if pos.IsNoProgress() {
+		return Draw, NoProgress
+	}
+	if !pos.HasLegalMoves() {
+		if pos.SideToMove == globals.WHITE && pos.IsInCheck() {
+			return BlackWins, Checkmate
+		}
+		return pos.NoLegalMovesResult(), NoLegalMoves
+	}
+	if pos.IsDeadPosition() {
+		return Draw, DeadPosition
+	}
+	return Ongoing, NoReason
+}
+
+// TerminalResult returns the result decided by the pieces on the board alone. It is cheap enough for every node of
+// the search, which finds the positions without legal moves and the repetitions on its own
+func (pos *Position) TerminalResult() (GameResult, ResultReason) {
+	// black wins if a black pawn reaches the goal rank, the squares from the goal row down are the highest bits
+	goalRow := globals.Geometry.Ranks - ActiveVariant.GoalRank
+	if pos.Bitboards[globals.BlackPawn]>>(goalRow*globals.Geometry.Files) != 0 {
+		return BlackWins, BlackPawnBreakthrough
+	}
+	// black wins if all white pieces are captured
+	if pos.Occupancies[globals.WHITE] == 0 {
+		return BlackWins, WhitePiecesCaptured
+	}
+	// white wins if all black pawns are captured
+	if pos.Occupancies[globals.BLACK] == 0 {
+		return WhiteWins, BlackPawnsCaptured
+	}
+	return Ongoing, NoReason
+}
+
+// NoLegalMovesResult returns the result of the game when the side to move has no legal move: black wins when the
+// white king is royal and in check, otherwise the stalemate outcome of the active variant decides
+func (pos *Position) NoLegalMovesResult() GameResult {
+	if pos.SideToMove == globals.WHITE && pos.IsInCheck() {
+		return BlackWins
+	}
+	return ActiveVariant.Stalemate.result(pos.SideToMove)
+}
+
+// HasLegalMoves returns true if the side to move has at least one legal move
+func (pos *Position) HasLegalMoves() bool {
+	moveList := Moves{}
+	pos.GenerateMoves(&moveList)
+	for i := 0; i < moveList.Count; i++ {
+		if pos.MakeMove(moveList.Moves[i], globals.AllMoves) == 1 {
+			pos.UnMakeMove()
+			return true
+		}
+	}
+	return false
+}
+
+// IsRepetition returns true if the current position has already occurred in the game history. Only the positions
+// since the last irreversible move with the same side to move can repeat it, so every other record is skipped
+func (pos *Position) IsRepetition() bool {
+	for i := pos.MoveCount - 2; i >= pos.IrreversibleIndex; i -= 2 {
+		if pos.MoveStack[i].hashKey == pos.HashKey {
+			return true
+		}
+	}
+	return false
+}
+
+// RepetitionCount returns the number of times the current position has occurred in the game history, counting the
+// current occurrence
+func (pos *Position) RepetitionCount() int {
+	count := 1
+	for i := pos.MoveCount - 2; i >= pos.IrreversibleIndex; i -= 2 {
+		if pos.MoveStack[i].hashKey == pos.HashKey {
+			count++
+		}
+	}
+	return count
+}
+
+// IsNoProgress returns true if the no-progress limit has been reached, NoProgressLimit moves in a row without a pawn
+// move or capture
+func (pos *Position) IsNoProgress() bool {
+	return globals.NoProgressLimit > 0 && pos.HalfmoveClock >= globals.NoProgressLimit
+}
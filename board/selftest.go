@@ -0,0 +1,427 @@
+package board
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"zerginator/globals"
+)
+
+/*
+	The self test checks that the engine is still sound after a change to the constants or tables in globals: the
+	slider attack tables, the incremental hash keys, the move generator and the undoing of moves. Every check stops
+	at its first failure and describes it, the random move sequences are played from a fixed seed so a failure can
+	be reproduced.
+*/
+
+// selfTestSeed is the seed of the random move sequences played by the self test
+const selfTestSeed = 1
+
+// Random move sequences played by the self test from every start position
+const (
+	selfTestGames = 200
+	selfTestPlies = 60
+)
+
+// perftReference is a perft leaf node count the move generator must reproduce
+type perftReference struct {
+	fen   string
+	depth int
+	nodes int
+}
+
+// perftReferences holds the reference node counts of the default board
+var perftReferences = []perftReference{
+	{globals.FenDebugStartPosition, 1, globals.LeafNodesEveryPlyFromStart[0]},
+	{globals.FenDebugStartPosition, 2, globals.LeafNodesEveryPlyFromStart[1]},
+	{globals.FenDebugStartPosition, 3, globals.LeafNodesEveryPlyFromStart[2]},
+	{globals.FenDebugStartPosition, 4, globals.LeafNodesEveryPlyFromStart[3]},
+	{globals.FenDebugStartPosition, 5, globals.LeafNodesEveryPlyFromStart[4]},
+	{globals.FenDebug2, 4, 28842},
+	{globals.FenDebug3, 4, 35008},
+	{globals.FenDebug4, 4, 42567},
+}
+
+// SelfTestCheck is a check of the self test, it returns a summary of what it checked or an error describing the
+// first failure
+type SelfTestCheck struct {
+	Name  string
+	Check func() (string, error)
+}
+
+// SelfTest runs every check of the board self test and prints a line for each, it returns an error describing the
+// first check that fails. The attack tables and hash keys must have been initialised
+func SelfTest() error {
+	fmt.Printf("\n\t--- Self test (%dx%d board) ---\n", globals.Geometry.Files, globals.Geometry.Ranks)
+	return RunSelfTestChecks([]SelfTestCheck{
+		{"slider attack tables", selfTestSliderAttacks},
+		{"start positions pass validation", selfTestStartPositionsValid},
+		{"FEN round trip", selfTestFENRoundTrip},
+		{"perft node counts", selfTestPerft},
+		{"hash keys and undo after random moves", selfTestRandomMoves},
+		{"mirror images", selfTestMirror},
+		{"generated positions", selfTestGenerator},
+	})
+}
+
+// RunSelfTestChecks runs the checks in order and prints a line for each, it returns an error describing the first
+// check that fails. Other packages run their own self test checks with it
+func RunSelfTestChecks(checks []SelfTestCheck) error {
+	for _, c := range checks {
+		summary, err := c.Check()
+		if err != nil {
+			fmt.Printf("\t%-40s FAILED\n", c.Name)
+			return fmt.Errorf("%s: %w", c.Name, err)
+		}
+		fmt.Printf("\t%-40s ok (%s)\n", c.Name, summary)
+	}
+	return nil
+}
+
+// selfTestSliderAttacks checks every occupancy subset of the slider tables against the on the fly attacks
+func selfTestSliderAttacks() (string, error) {
+	if err := CheckSliderAttacks(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d bishop and %d rook entries", len(globals.BishopAttacks), len(globals.RookAttacks)), nil
+}
+
+// selfTestStartPositionsValid loads every start arrangement and the debug positions that occur in games, which must
+// all pass Validate without a problem
+func selfTestStartPositionsValid() (string, error) {
+	fens := make([]string, 0, len(globals.FenStartWhiteBottomRow)+3)
+	for _, bottomRow := range globals.FenStartWhiteBottomRow {
+		fens = append(fens, StartPosFEN(bottomRow))
+	}
+	if globals.Geometry.IsDefault() {
+		fens = append(fens, globals.FenDebugStartPosition, globals.FenDebug2, globals.FenDebug3)
+	}
+	pos := NewPosition()
+	for _, fen := range fens {
+		problems, err := pos.LoadFEN(fen)
+		if err != nil {
+			return "", fmt.Errorf("%q: %w", fen, err)
+		}
+		if len(problems) > 0 {
+			return "", fmt.Errorf("%q: %s", fen, problems[0])
+		}
+	}
+	return fmt.Sprintf("%d positions", len(fens)), nil
+}
+
+// selfTestFENRoundTrip checks that ToFEN writes every start arrangement and debug FEN back exactly as ParseFEN read
+// it, with and without the move counters, and that the FEN of every position of random games sets up the same board
+func selfTestFENRoundTrip() (string, error) {
+	fens := make([]string, 0, len(globals.FenStartWhiteBottomRow)+4)
+	for _, bottomRow := range globals.FenStartWhiteBottomRow {
+		fens = append(fens, StartPosFEN(bottomRow))
+	}
+	if globals.Geometry.IsDefault() {
+		fens = append(fens, globals.FenDebugStartPosition, globals.FenDebug2, globals.FenDebug3, globals.FenDebug4)
+	}
+	pos := NewPosition()
+	checked := 0
+	for _, fen := range fens {
+		for _, counters := range []string{"", " 7", " 0 1", " 12 34"} {
+			if err := pos.ParseFEN(fen + counters); err != nil {
+				return "", err
+			}
+			if written := pos.ToFEN(); written != fen+counters {
+				return "", fmt.Errorf("%q is written back as %q", fen+counters, written)
+			}
+			checked++
+		}
+	}
+	// the positions of random games, with the move counters running
+	random := rand.New(rand.NewPCG(selfTestSeed, selfTestSeed))
+	played := NewPosition()
+	for _, fen := range selfTestStartPositions() {
+		if err := played.ParseFEN(fen + " 0 1"); err != nil {
+			return "", err
+		}
+		for plies := 0; plies < selfTestPlies && played.randomLegalMove(random) != NoMove; plies++ {
+			written := played.ToFEN()
+			if err := pos.ParseFEN(written); err != nil {
+				return "", fmt.Errorf("%q written after %s: %w", written, played.moveSequence(), err)
+			}
+			if differences := pos.state().differences(played.state()); differences != "" {
+				return "", fmt.Errorf("%q written after %s sets up a different board: %s",
+					written, played.moveSequence(), differences)
+			}
+			checked++
+		}
+	}
+	return fmt.Sprintf("%d FENs", checked), nil
+}
+
+// selfTestPerft compares the perft leaf node counts with the reference counts, which are only known for the
+// default board
+func selfTestPerft() (string, error) {
+	if !globals.Geometry.IsDefault() {
+		return "skipped, the reference counts are for the default board", nil
+	}
+	if !ActiveVariant.IsDefault() {
+		return "skipped, the reference counts are for the default rules", nil
+	}
+	pos := NewPosition()
+	for _, reference := range perftReferences {
+		// FenDebug4 stresses the move generator with a white pawn on rank 1, which Validate rejects, so the
+		// references are parsed without it
+		if err := pos.ParseFEN(reference.fen); err != nil {
+			return "", err
+		}
+		if _, nodes := pos.PerftDriver(reference.depth); nodes != reference.nodes {
+			return "", fmt.Errorf("perft %d of %q counted %d leaf nodes, expected %d",
+				reference.depth, reference.fen, nodes, reference.nodes)
+		}
+	}
+	return fmt.Sprintf("%d positions", len(perftReferences)), nil
+}
+
+// boardState is the part of a position that MakeMove changes and UnMakeMove must restore
+type boardState struct {
+	bitboards       [globals.NoPiece]globals.Bitboard
+	occupancies     [3]globals.Bitboard
+	mailbox         [globals.MaxSquares]globals.Piece
+	sideToMove      globals.Color
+	enPassantSquare globals.Square
+	hashKey         uint64
+	halfmoveClock   int
+	fullmoveNumber  int
+}
+
+// state returns the board state of the position
+func (pos *Position) state() boardState {
+	return boardState{pos.Bitboards, pos.Occupancies, pos.Mailbox, pos.SideToMove, pos.EnPassantSquare, pos.HashKey,
+		pos.HalfmoveClock, pos.FullmoveNumber}
+}
+
+// differences lists the fields of the board state that differ from the expected state
+func (s boardState) differences(expected boardState) string {
+	var fields []string
+	if s.bitboards != expected.bitboards {
+		fields = append(fields, "piece bitboards")
+	}
+	if s.occupancies != expected.occupancies {
+		fields = append(fields, "occupancies")
+	}
+	if s.mailbox != expected.mailbox {
+		fields = append(fields, "mailbox")
+	}
+	if s.sideToMove != expected.sideToMove {
+		fields = append(fields, "side to move")
+	}
+	if s.enPassantSquare != expected.enPassantSquare {
+		fields = append(fields, fmt.Sprintf("en passant square %s instead of %s", s.enPassantSquare, expected.enPassantSquare))
+	}
+	if s.hashKey != expected.hashKey {
+		fields = append(fields, fmt.Sprintf("hash key %x instead of %x", s.hashKey, expected.hashKey))
+	}
+	if s.halfmoveClock != expected.halfmoveClock {
+		fields = append(fields, fmt.Sprintf("halfmove clock %d instead of %d", s.halfmoveClock, expected.halfmoveClock))
+	}
+	if s.fullmoveNumber != expected.fullmoveNumber {
+		fields = append(fields, fmt.Sprintf("fullmove number %d instead of %d", s.fullmoveNumber, expected.fullmoveNumber))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// selfTestStartPositions returns the positions the random move sequences start from
+func selfTestStartPositions() []string {
+	fens := []string{
+		StartPosFEN(globals.FenStartWhiteBottomRow[0]),
+		StartPosFEN(globals.FenStartWhiteBottomRow[59]),
+		StartPosFEN(globals.FenStartWhiteBottomRow[119]),
+	}
+	if globals.Geometry.IsDefault() {
+		fens = append(fens, globals.FenDebug2, globals.FenDebug3, globals.FenDebug4)
+	}
+	return fens
+}
+
+// selfTestPositionGames is the number of random games played from every start position by SelfTestPositions
+const selfTestPositionGames = 10
+
+// SelfTestPositions returns the FENs of the positions the self tests check their invariants on: every start
+// arrangement, the debug positions on the default board and the positions of random games played from a fixed seed
+func SelfTestPositions() ([]string, error) {
+	var fens []string
+	for _, bottomRow := range globals.FenStartWhiteBottomRow {
+		fens = append(fens, StartPosFEN(bottomRow))
+	}
+	if globals.Geometry.IsDefault() {
+		fens = append(fens, globals.FenDebugStartPosition, globals.FenDebug2, globals.FenDebug3, globals.FenDebug4)
+	}
+	random := rand.New(rand.NewPCG(selfTestSeed, selfTestSeed))
+	pos := NewPosition()
+	for _, fen := range selfTestStartPositions() {
+		for game := 0; game < selfTestPositionGames; game++ {
+			if err := pos.ParseFEN(fen); err != nil {
+				return nil, err
+			}
+			for plies := 0; plies < selfTestPlies && pos.randomLegalMove(random) != NoMove; plies++ {
+				fens = append(fens, pos.ToFEN())
+			}
+		}
+	}
+	return fens, nil
+}
+
+// selfTestMirrorDepth is the perft depth compared between a position and its mirror image
+const selfTestMirrorDepth = 3
+
+// selfTestMirror checks on the self test positions that the mirror image is a valid position with the hash key
+// MirrorKey gives and the same canonical key, that mirroring twice gives the position back, and that the position
+// and its mirror image have the same perft counts. On the default board the mirrored start positions must be the
+// start positions of the mirrored arrangements
+func selfTestMirror() (string, error) {
+	fens, err := SelfTestPositions()
+	if err != nil {
+		return "", err
+	}
+	pos := NewPosition()
+	for _, fen := range fens {
+		if err := pos.ParseFEN(fen); err != nil {
+			return "", err
+		}
+		mirrored := pos.Mirrored()
+		if err := mirrored.CheckInvariants(); err != nil {
+			return "", fmt.Errorf("mirror image %q of %q: %w", mirrored.ToFEN(), fen, err)
+		}
+		if mirrored.HashKey != pos.MirrorKey() {
+			return "", fmt.Errorf("mirror image of %q has the hash key %x, MirrorKey gives %x",
+				fen, mirrored.HashKey, pos.MirrorKey())
+		}
+		if mirrored.CanonicalKey() != pos.CanonicalKey() {
+			return "", fmt.Errorf("%q and its mirror image %q have different canonical keys", fen, mirrored.ToFEN())
+		}
+		if differences := mirrored.Mirrored().state().differences(pos.state()); differences != "" {
+			return "", fmt.Errorf("mirroring %q twice gives a different board: %s", fen, differences)
+		}
+		_, nodes := pos.PerftDriver(selfTestMirrorDepth)
+		if _, mirrorNodes := mirrored.PerftDriver(selfTestMirrorDepth); mirrorNodes != nodes {
+			return "", fmt.Errorf("perft %d of %q counted %d leaf nodes, of its mirror image %q %d",
+				selfTestMirrorDepth, fen, nodes, mirrored.ToFEN(), mirrorNodes)
+		}
+	}
+	if globals.Geometry.IsDefault() {
+		for index := range globals.FenStartWhiteBottomRow {
+			mirror, err := MirrorStartArrangement(index)
+			if err != nil {
+				return "", err
+			}
+			fen, _ := StartPositionFEN(index)
+			mirrorFEN, _ := StartPositionFEN(mirror)
+			if err := pos.ParseFEN(fen); err != nil {
+				return "", err
+			}
+			if written := pos.Mirrored().ToFEN(); written != mirrorFEN {
+				return "", fmt.Errorf("mirror image of arrangement %d is %q, arrangement %d is %q",
+					index, written, mirror, mirrorFEN)
+			}
+		}
+	}
+	return fmt.Sprintf("%d positions, perft %d", len(fens), selfTestMirrorDepth), nil
+}
+
+// selfTestGeneratedPositions is the number of positions selfTestGenerator generates
+const selfTestGeneratedPositions = 200
+
+// selfTestGenerator checks that the position generator returns positions that pass validation and match its
+// options, and that the same seed generates the same positions
+func selfTestGenerator() (string, error) {
+	options := DefaultGeneratorOptions()
+	options.Seed = selfTestSeed
+	options.CaptureWeight = 4
+	generators := [2]*PositionGenerator{}
+	for i := range generators {
+		generator, err := NewPositionGenerator(options)
+		if err != nil {
+			return "", err
+		}
+		generators[i] = generator
+	}
+	pos := NewPosition()
+	for i := 0; i < selfTestGeneratedPositions; i++ {
+		fen, err := generators[0].Next()
+		if err != nil {
+			return "", err
+		}
+		if again, _ := generators[1].Next(); again != fen {
+			return "", fmt.Errorf("position %d is %q, with the same seed %q", i+1, fen, again)
+		}
+		problems, err := pos.LoadFEN(fen)
+		if err != nil {
+			return "", fmt.Errorf("generated %q: %w", fen, err)
+		}
+		if len(problems) > 0 {
+			return "", fmt.Errorf("generated %q: %s", fen, problems[0])
+		}
+		if plies := 2*(pos.FullmoveNumber-1) + int(pos.SideToMove); plies < options.MinPly || plies > options.MaxPly {
+			return "", fmt.Errorf("generated %q after %d plies, outside %d to %d", fen, plies, options.MinPly, options.MaxPly)
+		}
+	}
+	return fmt.Sprintf("%d positions, seed %d", selfTestGeneratedPositions, selfTestSeed), nil
+}
+
+// selfTestRandomMoves plays random legal move sequences, checking after every move that the incremental hash key
+// matches GeneratePositionKey, then takes the moves back checking that each UnMakeMove restores the exact board
+func selfTestRandomMoves() (string, error) {
+	random := rand.New(rand.NewPCG(selfTestSeed, selfTestSeed))
+	pos := NewPosition()
+	var states [selfTestPlies]boardState
+	var played [selfTestPlies]Move
+	movesPlayed := 0
+	for _, fen := range selfTestStartPositions() {
+		for game := 0; game < selfTestGames; game++ {
+			if err := pos.ParseFEN(fen); err != nil {
+				return "", err
+			}
+			// sequence describes the moves played so far for the error messages
+			sequence := func(n int) string {
+				moves := make([]string, n)
+				for i := range moves {
+					moves[i] = played[i].String()
+				}
+				return fmt.Sprintf("%q moves %s", fen, strings.Join(moves, " "))
+			}
+			plies := 0
+			for ; plies < selfTestPlies; plies++ {
+				state := pos.state()
+				move := pos.randomLegalMove(random)
+				if move == NoMove {
+					break
+				}
+				states[plies], played[plies] = state, move
+				if key := pos.GeneratePositionKey(); pos.HashKey != key {
+					return "", fmt.Errorf("after %s the hash key is %x, GeneratePositionKey gives %x",
+						sequence(plies+1), pos.HashKey, key)
+				}
+			}
+			movesPlayed += plies
+			for ply := plies - 1; ply >= 0; ply-- {
+				pos.UnMakeMove()
+				if state := pos.state(); state != states[ply] {
+					return "", fmt.Errorf("after %s, UnMakeMove of %s left a different board: %s",
+						sequence(ply+1), played[ply], state.differences(states[ply]))
+				}
+			}
+		}
+	}
+	return fmt.Sprintf("%d moves, seed %d", movesPlayed, selfTestSeed), nil
+}
+
+// randomLegalMove makes a random legal move and returns it, or returns NoMove when the side to move cannot move
+func (pos *Position) randomLegalMove(random *rand.Rand) Move {
+	moveList := Moves{}
+	pos.GenerateMoves(&moveList)
+	// try the moves in a random order until one of them is legal
+	for n := moveList.Count; n > 0; n-- {
+		i := random.IntN(n)
+		if pos.MakeMove(moveList.Moves[i], globals.AllMoves) == 1 {
+			return moveList.Moves[i]
+		}
+		moveList.Moves[i] = moveList.Moves[n-1]
+	}
+	return NoMove
+}
@@ -0,0 +1,162 @@
+package board
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+//go:generate go run zerginator/cmd/tablegen -o attack_tables.go
+
+/*
+	The attack tables of the default board are generated ahead of time by cmd/tablegen, which searches the magic
+	numbers, checks the slider tables against the attacks generated on the fly, and writes attack_tables.go. Run
+	`go generate ./board` to regenerate the file after changing the attack masks or the default board layout.
+	Boards of any other size build their tables when the engine starts.
+*/
+
+// generatedAttackTables holds the tables of attack_tables.go, it stays nil when the file has not been generated
+var generatedAttackTables *attackTables
+
+// attackTables holds every attack table of one board geometry, as written out by cmd/tablegen
+type attackTables struct {
+	geometry            globals.BoardGeometry
+	pawnAttacks         [2][globals.MaxSquares]globals.Bitboard
+	knightAttacks       [globals.MaxSquares]globals.Bitboard
+	kingAttacks         [globals.MaxSquares]globals.Bitboard
+	bishopMasks         [globals.MaxSquares]globals.Bitboard
+	rookMasks           [globals.MaxSquares]globals.Bitboard
+	bishopMagicNumbers  [globals.MaxSquares]uint64
+	rookMagicNumbers    [globals.MaxSquares]uint64
+	bishopAttackOffsets [globals.MaxSquares]int
+	rookAttackOffsets   [globals.MaxSquares]int
+	bishopAttacks       []globals.Bitboard
+	rookAttacks         []globals.Bitboard
+}
+
+// InitAttackTables fills the leaper and slider attack tables for the board geometry in play. The generated tables
+// are used when they match the geometry, otherwise the magic numbers are searched and the tables are built
+func InitAttackTables() {
+	if generatedAttackTables != nil && generatedAttackTables.geometry == globals.Geometry {
+		generatedAttackTables.load()
+		return
+	}
+	InitLeapersAttacks()
+	InitMagicNumbers()
+	InitSlidersAttacks(globals.BISHOP)
+	InitSlidersAttacks(globals.ROOK)
+}
+
+// load copies the tables into globals, the occupancy counts and shifts follow from the masks
+func (t *attackTables) load() {
+	globals.PawnAttacks = t.pawnAttacks
+	globals.KnightAttacks = t.knightAttacks
+	globals.KingAttacks = t.kingAttacks
+	globals.BishopMasks = t.bishopMasks
+	globals.RookMasks = t.rookMasks
+	globals.BishopMagicNumbers = t.bishopMagicNumbers
+	globals.RookMagicNumbers = t.rookMagicNumbers
+	globals.BishopAttackOffsets = t.bishopAttackOffsets
+	globals.RookAttackOffsets = t.rookAttackOffsets
+	// the tables are only read once loaded, so the slices can share the generated arrays
+	globals.BishopAttacks = t.bishopAttacks
+	globals.RookAttacks = t.rookAttacks
+	for square := globals.Square(0); square < t.geometry.Squares(); square++ {
+		globals.BishopRelevantOccupancyCount[square] = bitoperations.CountBits(t.bishopMasks[square])
+		globals.RookRelevantOccupancyCount[square] = bitoperations.CountBits(t.rookMasks[square])
+		globals.BishopShifts[square] = uint(64 - globals.BishopRelevantOccupancyCount[square])
+		globals.RookShifts[square] = uint(64 - globals.RookRelevantOccupancyCount[square])
+	}
+}
+
+// WriteAttackTables writes the Go source of attack_tables.go for the attack tables in globals, which must have been
+// built for the current geometry
+func WriteAttackTables(w io.Writer) error {
+	var b bytes.Buffer
+	squares := int(globals.Geometry.Squares())
+	// writeTable writes the first n entries of a table, perLine entries to a line
+	writeTable := func(typeName string, table []string, n int, perLine int) {
+		fmt.Fprintf(&b, "%s{\n", typeName)
+		for i := 0; i < n; i++ {
+			fmt.Fprintf(&b, "%s,", table[i])
+			if (i+1)%perLine == 0 || i == n-1 {
+				b.WriteString("\n")
+			} else {
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString("}")
+	}
+	bitboards := func(table []globals.Bitboard) []string {
+		entries := make([]string, len(table))
+		for i, bitboard := range table {
+			entries[i] = fmt.Sprintf("%#x", uint64(bitboard))
+		}
+		return entries
+	}
+	numbers := func(table []uint64) []string {
+		entries := make([]string, len(table))
+		for i, number := range table {
+			entries[i] = fmt.Sprintf("%#x", number)
+		}
+		return entries
+	}
+	offsets := func(table []int) []string {
+		entries := make([]string, len(table))
+		for i, offset := range table {
+			entries[i] = strconv.Itoa(offset)
+		}
+		return entries
+	}
+	files := globals.Geometry.Files
+
+	fmt.Fprintf(&b, "// Code generated by cmd/tablegen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package board\n\nimport \"zerginator/globals\"\n\n")
+	fmt.Fprintf(&b, "// the attack tables of the %dx%d board\n", globals.Geometry.Files, globals.Geometry.Ranks)
+	fmt.Fprintf(&b, "func init() {\ngeneratedAttackTables = &attackTables{\n")
+	fmt.Fprintf(&b, "geometry: globals.BoardGeometry{Files: %d, Ranks: %d},\n", globals.Geometry.Files, globals.Geometry.Ranks)
+	fmt.Fprintf(&b, "pawnAttacks: [2][globals.MaxSquares]globals.Bitboard{\n")
+	for side := range globals.PawnAttacks {
+		writeTable("", bitboards(globals.PawnAttacks[side][:]), squares, files)
+		b.WriteString(",\n")
+	}
+	b.WriteString("},\n")
+	squareTables := []struct {
+		name  string
+		table []string
+	}{
+		{"knightAttacks", bitboards(globals.KnightAttacks[:])},
+		{"kingAttacks", bitboards(globals.KingAttacks[:])},
+		{"bishopMasks", bitboards(globals.BishopMasks[:])},
+		{"rookMasks", bitboards(globals.RookMasks[:])},
+	}
+	for _, table := range squareTables {
+		fmt.Fprintf(&b, "%s: ", table.name)
+		writeTable("[globals.MaxSquares]globals.Bitboard", table.table, squares, files)
+		b.WriteString(",\n")
+	}
+	b.WriteString("bishopMagicNumbers: ")
+	writeTable("[globals.MaxSquares]uint64", numbers(globals.BishopMagicNumbers[:]), squares, files)
+	b.WriteString(",\nrookMagicNumbers: ")
+	writeTable("[globals.MaxSquares]uint64", numbers(globals.RookMagicNumbers[:]), squares, files)
+	b.WriteString(",\nbishopAttackOffsets: ")
+	writeTable("[globals.MaxSquares]int", offsets(globals.BishopAttackOffsets[:]), squares, files)
+	b.WriteString(",\nrookAttackOffsets: ")
+	writeTable("[globals.MaxSquares]int", offsets(globals.RookAttackOffsets[:]), squares, files)
+	b.WriteString(",\nbishopAttacks: ")
+	writeTable("[]globals.Bitboard", bitboards(globals.BishopAttacks), len(globals.BishopAttacks), 4)
+	b.WriteString(",\nrookAttacks: ")
+	writeTable("[]globals.Bitboard", bitboards(globals.RookAttacks), len(globals.RookAttacks), 4)
+	b.WriteString(",\n}\n}\n")
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting the generated tables: %w", err)
+	}
+	_, err = w.Write(source)
+	return err
+}
@@ -0,0 +1,154 @@
+package board
+
+import (
+	"fmt"
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+/*
+	A well-formed FEN can still describe a position that cannot occur in a game. White starts with a rank of pawns and
+	at most one piece of each kind on the bottom row, a queen only in the bottom rows of BottomRowFEN, black with three
+	ranks of pawns. White pawns only move up and promote on the top rank, black pawns only move down, so pawns can
+	never be added and every extra white piece stands for a white pawn that promoted. Validate lists what contradicts
+	this, an impossible position is an error and a position that can occur but ends the game is a warning.
+*/
+
+// Severity is how serious a problem found by Validate is
+type Severity int
+
+// Severity levels
+const (
+	// Warning is a position that can occur but is unusual, such as a game that is already over
+	Warning Severity = iota
+	// Error is a position that cannot occur in a game
+	Error
+)
+
+// String returns the name of the severity level
+func (s Severity) String() string {
+	if s == Error {
+		return "error"
+	}
+	return "warning"
+}
+
+// PositionProblem is a problem found by Validate
+type PositionProblem struct {
+	Severity Severity
+	Message  string
+}
+
+// String returns the problem with its severity, for example "error: 6 white pawns, at most 5 fit the start position"
+func (p PositionProblem) String() string {
+	return p.Severity.String() + ": " + p.Message
+}
+
+// startPieces is the most pieces of each kind white can start with on the bottom row
+const startPieces = 1
+
+// Validate checks that the position can occur in a game and returns every problem it finds, or nil if there are none
+func (pos *Position) Validate() []PositionProblem {
+	var problems []PositionProblem
+	report := func(severity Severity, format string, args ...any) {
+		problems = append(problems, PositionProblem{severity, fmt.Sprintf(format, args...)})
+	}
+	files, ranks := globals.Geometry.Files, globals.Geometry.Ranks
+	whitePawns, blackPawns := pos.Bitboards[globals.WhitePawn], pos.Bitboards[globals.BlackPawn]
+
+	// pawn counts
+	if count := bitoperations.CountBits(whitePawns); count > files {
+		report(Error, "%d white pawns, at most %d fit the start position", count, files)
+	}
+	if count := bitoperations.CountBits(blackPawns); count > 3*files {
+		report(Error, "%d black pawns, at most %d fit the start position", count, 3*files)
+	}
+	// pawns on rows they can never stand on
+	if pawns := whitePawns & globals.RowMasks[0]; pawns != 0 {
+		report(Error, "white pawn on rank %d would have promoted: %s", ranks, pawns)
+	}
+	if pawns := whitePawns & globals.RowMasks[ranks-1]; pawns != 0 {
+		report(Error, "white pawn on rank 1, below the rank the white pawns start on: %s", pawns)
+	}
+	// the game ends on the black move that brings the first black pawn to the goal rank
+	goalRow := ranks - ActiveVariant.GoalRank
+	goalPawns := blackPawns & globals.RowMasks[goalRow]
+	if count := bitoperations.CountBits(goalPawns); count > 1 {
+		report(Error, "%d black pawns on the goal rank %d, the game ends when the first one reaches it: %s",
+			count, ActiveVariant.GoalRank, goalPawns)
+	} else if count == 1 && pos.SideToMove == globals.BLACK {
+		report(Error, "black pawn on the goal rank %d with black to move, the game ended on the move that brought it "+
+			"there: %s", ActiveVariant.GoalRank, goalPawns)
+	}
+	var beyondGoal globals.Bitboard
+	for row := goalRow + 1; row < ranks; row++ {
+		beyondGoal |= globals.RowMasks[row]
+	}
+	if pawns := blackPawns & beyondGoal; pawns != 0 {
+		report(Error, "black pawn beyond the goal rank %d, the game ended when it reached the goal rank: %s",
+			ActiveVariant.GoalRank, pawns)
+	}
+	// the white pieces beyond the start pieces must come from promoted pawns
+	promoted := 0
+	for piece := globals.WhiteKnight; piece <= globals.WhiteKing; piece++ {
+		extra := max(0, bitoperations.CountBits(pos.Bitboards[piece])-startPieces)
+		if extra > 0 && !ActiveVariant.CanPromoteTo(piece) {
+			report(Error, "%d white %s pieces beyond the start pieces, but pawns cannot promote to %s", extra, piece, piece)
+		}
+		promoted += extra
+	}
+	if missing := max(0, files-bitoperations.CountBits(whitePawns)); promoted > missing {
+		report(Error, "%d white pieces beyond the start pieces, but only %d white pawns can have promoted",
+			promoted, missing)
+	}
+	// the en passant square needs a white pawn that just double pushed from the row behind it
+	if pos.EnPassantSquare != globals.NoSquare {
+		if err := pos.checkEnPassantSquare(); err != nil {
+			report(Error, "%v", err)
+		} else if origin := pos.EnPassantSquare + globals.Square(files); pos.PieceAt(origin) != globals.NoPiece {
+			report(Error, "en passant square %s but the double push start square %s is occupied",
+				pos.EnPassantSquare, origin)
+		}
+	}
+	// a royal king is never captured and never left attacked
+	if !ActiveVariant.KingCapturable {
+		if pos.Bitboards[globals.WhiteKing] == 0 {
+			report(Error, "no white king, the royal king cannot be captured")
+		} else if pos.SideToMove == globals.BLACK && pos.IsInCheck() {
+			report(Error, "the royal white king is attacked with black to move")
+		}
+	}
+	// positions where the game is already over
+	if result, reason := pos.TerminalResult(); result != Ongoing {
+		report(Warning, "the game is over, %s by %s", result, reason)
+	}
+	return problems
+}
+
+// firstError returns the first problem with Error severity as an error, or nil if there is none
+func firstError(problems []PositionProblem) error {
+	for _, problem := range problems {
+		if problem.Severity == Error {
+			return fmt.Errorf("impossible position: %s", problem.Message)
+		}
+	}
+	return nil
+}
+
+// LoadFEN sets up the position from the FEN string if it is well-formed and passes Validate. It returns the problems
+// Validate found, and an error if the FEN is malformed or describes an impossible position, in which case the
+// position is left untouched
+func (pos *Position) LoadFEN(fen string) ([]PositionProblem, error) {
+	loaded := NewPosition()
+	if err := loaded.ParseFEN(fen); err != nil {
+		return nil, err
+	}
+	problems := loaded.Validate()
+	if err := firstError(problems); err != nil {
+		return problems, err
+	}
+	// the game history starts again, its records are reused
+	loaded.MoveStack = pos.MoveStack[:0]
+	*pos = *loaded
+	return problems, nil
+}
@@ -0,0 +1,31 @@
+package board
+
+import "testing"
+
+func TestValidateBlackPawnsOnTheGoalRank(t *testing.T) {
+	for _, test := range []struct {
+		fen      string
+		severity Severity
+	}{
+		{"ppppp/ppppp/pppp1/5/5/5/PPPP1/RNKpB w -", Warning}, // the game just ended
+		{"ppppp/ppppp/ppp2/5/5/5/PPP2/RNpKp w -", Error},     // two pawns reached the goal rank
+		{"ppppp/ppppp/pppp1/5/5/5/PPPP1/RNKpB b -", Error},   // black to move after the game ended
+	} {
+		pos := NewPosition()
+		if err := pos.ParseFEN(test.fen); err != nil {
+			t.Fatal(err)
+		}
+		problems := pos.Validate()
+		if len(problems) == 0 {
+			t.Errorf("Validate(%q) found no problem", test.fen)
+			continue
+		}
+		severity := Warning
+		for _, problem := range problems {
+			severity = max(severity, problem.Severity)
+		}
+		if severity != test.severity {
+			t.Errorf("Validate(%q) = %v, expected a problem of severity %s", test.fen, problems, test.severity)
+		}
+	}
+}
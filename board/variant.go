@@ -0,0 +1,212 @@
+package board
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"zerginator/bitoperations"
+	"zerginator/globals"
+)
+
+/*
+	A variant changes the rules of the horde game. Under the default rules the black pawns only step one square, white
+	promotes to a knight, bishop, rook, queen or king, black wins when a pawn reaches rank 1, a side without legal
+	moves draws and the white king is captured like any other piece. A variant can let the black pawns on their front
+	start rank step two squares, change the promotion pieces, move the rank black must reach, decide who wins when a
+	side has no legal moves, and make the white king royal: white may not leave it attacked, and white has lost when
+	it is attacked and white has no legal move. White never captures en passant, a black double step leaves no en
+	passant square.
+
+	The active variant is read by the move generator, MakeMove, the result checks and the evaluation. A variant file
+	is JSON, the fields left out keep their default rules, for example:
+
+		{"name": "royal king", "blackDoubleSteps": true, "promotions": "NBR", "goalRank": 2,
+		 "stalemate": "loss", "kingCapturable": false}
+*/
+
+// StalemateOutcome is the result of the game when the side to move has no legal move and is not in check
+type StalemateOutcome int
+
+// Stalemate outcomes
+const (
+	// StalemateDraw draws the game
+	StalemateDraw StalemateOutcome = iota
+	// StalemateLoss loses the game for the side without legal moves
+	StalemateLoss
+	// StalemateWin wins the game for the side without legal moves
+	StalemateWin
+	// StalemateWhiteWins wins the game for white whoever has no legal moves
+	StalemateWhiteWins
+	// StalemateBlackWins wins the game for black whoever has no legal moves
+	StalemateBlackWins
+)
+
+// stalemateNames holds the name of each stalemate outcome in variant files
+var stalemateNames = []string{"draw", "loss", "win", "white", "black"}
+
+// String returns the name of the stalemate outcome, for example "loss"
+func (o StalemateOutcome) String() string {
+	if o >= 0 && int(o) < len(stalemateNames) {
+		return stalemateNames[o]
+	}
+	return "unknown outcome"
+}
+
+// ParseStalemateOutcome returns the stalemate outcome with the given name
+func ParseStalemateOutcome(s string) (StalemateOutcome, error) {
+	if index := slices.Index(stalemateNames, s); index != -1 {
+		return StalemateOutcome(index), nil
+	}
+	return StalemateDraw, fmt.Errorf("invalid stalemate outcome %q, expected one of %v", s, stalemateNames)
+}
+
+// result returns the result of the game when the given side has no legal moves
+func (o StalemateOutcome) result(side globals.Color) GameResult {
+	switch {
+	case o == StalemateWhiteWins, o == StalemateLoss && side == globals.BLACK, o == StalemateWin && side == globals.WHITE:
+		return WhiteWins
+	case o == StalemateBlackWins, o == StalemateLoss && side == globals.WHITE, o == StalemateWin && side == globals.BLACK:
+		return BlackWins
+	}
+	return Draw
+}
+
+// Variant holds the rules of the game that can be changed
+type Variant struct {
+	// Name is shown to the players
+	Name string
+	// BlackDoubleSteps lets the black pawns on their front start rank step two squares
+	BlackDoubleSteps bool
+	// Promotions holds the pieces a white pawn can promote to
+	Promotions []globals.Piece
+	// GoalRank is the rank black wins the game on by reaching it with a pawn, 1 being the white bottom row
+	GoalRank int
+	// Stalemate is the result of the game when the side to move has no legal move and is not in check
+	Stalemate StalemateOutcome
+	// KingCapturable lets black capture the white king, otherwise the king is royal
+	KingCapturable bool
+}
+
+// DefaultVariant returns the default rules of the horde game
+func DefaultVariant() Variant {
+	return Variant{
+		Name:           "horde",
+		Promotions:     slices.Clone(globals.PromotedPieces),
+		GoalRank:       1,
+		Stalemate:      StalemateDraw,
+		KingCapturable: true,
+	}
+}
+
+// ActiveVariant holds the rules of the games being played, it is changed with SetVariant
+var ActiveVariant = DefaultVariant()
+
+// IsDefault returns true if the variant has the default rules, whatever its name
+func (v Variant) IsDefault() bool {
+	d := DefaultVariant()
+	return v.BlackDoubleSteps == d.BlackDoubleSteps && slices.Equal(v.Promotions, d.Promotions) &&
+		v.GoalRank == d.GoalRank && v.Stalemate == d.Stalemate && v.KingCapturable == d.KingCapturable
+}
+
+// CanPromoteTo returns true if a white pawn can promote to the piece
+func (v Variant) CanPromoteTo(piece globals.Piece) bool {
+	return slices.Contains(v.Promotions, piece)
+}
+
+// Check returns an error describing the first rule of the variant that cannot be played on the current board
+func (v Variant) Check() error {
+	if len(v.Promotions) == 0 {
+		return fmt.Errorf("variant %q has no promotion pieces", v.Name)
+	}
+	for i, piece := range v.Promotions {
+		if piece <= globals.WhitePawn || piece >= globals.BlackPawn {
+			return fmt.Errorf("variant %q promotes to %s, which is not a white piece", v.Name, piece)
+		}
+		if slices.Contains(v.Promotions[:i], piece) {
+			return fmt.Errorf("variant %q lists the promotion to %s twice", v.Name, piece)
+		}
+	}
+	if !v.KingCapturable && v.CanPromoteTo(globals.WhiteKing) {
+		return fmt.Errorf("variant %q has a royal king, a pawn cannot promote to it", v.Name)
+	}
+	// the goal rank must lie below the three ranks the black pawns start on
+	if v.GoalRank < 1 || v.GoalRank > globals.Geometry.Ranks-3 {
+		return fmt.Errorf("variant %q has the goal rank %d, it must be between 1 and %d",
+			v.Name, v.GoalRank, globals.Geometry.Ranks-3)
+	}
+	if v.Stalemate < StalemateDraw || v.Stalemate > StalemateBlackWins {
+		return fmt.Errorf("variant %q has an invalid stalemate outcome %d", v.Name, v.Stalemate)
+	}
+	return nil
+}
+
+// SetVariant makes the variant the active variant if its rules can be played on the current board
+func SetVariant(v Variant) error {
+	if err := v.Check(); err != nil {
+		return err
+	}
+	v.Promotions = slices.Clone(v.Promotions)
+	ActiveVariant = v
+	return nil
+}
+
+// variantFile is the JSON form of a variant
+type variantFile struct {
+	Name             string `json:"name"`
+	BlackDoubleSteps bool   `json:"blackDoubleSteps"`
+	Promotions       string `json:"promotions"`
+	GoalRank         int    `json:"goalRank"`
+	Stalemate        string `json:"stalemate"`
+	KingCapturable   bool   `json:"kingCapturable"`
+}
+
+// LoadVariant reads a variant from a JSON file, the fields left out of the file keep their default rules. The
+// variant is checked but not made active
+func LoadVariant(path string) (Variant, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Variant{}, err
+	}
+	return ParseVariant(data)
+}
+
+// ParseVariant parses a variant from its JSON form, the fields left out keep their default rules
+func ParseVariant(data []byte) (Variant, error) {
+	d := DefaultVariant()
+	file := variantFile{d.Name, d.BlackDoubleSteps, "", d.GoalRank, d.Stalemate.String(), d.KingCapturable}
+	for _, piece := range d.Promotions {
+		file.Promotions += piece.String()
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return Variant{}, fmt.Errorf("invalid variant: %w", err)
+	}
+	v := Variant{Name: file.Name, BlackDoubleSteps: file.BlackDoubleSteps, GoalRank: file.GoalRank,
+		KingCapturable: file.KingCapturable}
+	for _, ch := range file.Promotions {
+		piece, err := globals.ParsePiece(ch)
+		if err != nil {
+			return Variant{}, fmt.Errorf("invalid promotions %q of variant %q: %w", file.Promotions, v.Name, err)
+		}
+		v.Promotions = append(v.Promotions, piece)
+	}
+	stalemate, err := ParseStalemateOutcome(file.Stalemate)
+	if err != nil {
+		return Variant{}, fmt.Errorf("variant %q: %w", v.Name, err)
+	}
+	v.Stalemate = stalemate
+	if err := v.Check(); err != nil {
+		return Variant{}, err
+	}
+	return v, nil
+}
+
+// IsInCheck returns true if the white king is royal and attacked by a black pawn
+func (pos *Position) IsInCheck() bool {
+	if ActiveVariant.KingCapturable {
+		return false
+	}
+	blackPawns := pos.Bitboards[globals.BlackPawn]
+	attacks := bitoperations.SouthWest(blackPawns) | bitoperations.SouthEast(blackPawns)
+	return attacks&pos.Bitboards[globals.WhiteKing] != 0
+}
@@ -68,7 +68,7 @@ func (c *Clock) IsExpired() bool {
 type GameClock struct {
 	White *Clock
 	Black *Clock
-	turn  int
+	turn  globals.Color
 }
 
 // NewGameClock creates a new GameClock with default time settings.
@@ -76,7 +76,7 @@ func NewGameClock() *GameClock {
 	return &GameClock{
 		White: NewClock(10),
 		Black: NewClock(10),
-		turn:  0,
+		turn:  globals.WHITE,
 	}
 }
 
@@ -0,0 +1,96 @@
+/*
+Posgen generates random positions that can occur in a game and writes their FENs, one per line. It plays random games
+from the start arrangements and takes a position from each, after a number of plies in the given range or, with
+-material, the first position within the range with that material:
+
+	go run zerginator/cmd/posgen -n 1000 -seed 7 [-arrangement 37] [-minply 10] [-maxply 60] [-material KRP3p5]
+		[-captures 4] [-o positions.txt] [-files 5] [-ranks 8]
+
+A position no game reaches is skipped with a warning. Posgen gives up after maxFailedDraws such positions in a row,
+keeping the positions it generated, and exits with an error if it generated fewer than asked for.
+*/
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+// maxFailedDraws is the number of positions in a row no game reaches after which posgen gives up
+const maxFailedDraws = 3
+
+func main() {
+	files := flag.Int("files", globals.DefaultFiles, "number of files on the board")
+	ranks := flag.Int("ranks", globals.DefaultRanks, "number of ranks on the board")
+	options := board.DefaultGeneratorOptions()
+	count := flag.Int("n", 100, "number of positions to generate")
+	flag.Uint64Var(&options.Seed, "seed", options.Seed, "seed of the random arrangements and moves")
+	arrangement := flag.String("arrangement", "random", "start arrangement, an index, a bottom row or random")
+	flag.IntVar(&options.MinPly, "minply", options.MinPly, "fewest plies played before a position is taken")
+	flag.IntVar(&options.MaxPly, "maxply", options.MaxPly, "most plies played before a position is taken")
+	flag.StringVar(&options.Material, "material", options.Material,
+		"material signature of the positions such as KRP3p5, empty for any material")
+	flag.IntVar(&options.CaptureWeight, "captures", options.CaptureWeight,
+		"how many times as likely as a quiet move a capture or promotion is played")
+	output := flag.String("o", "", "file to write the FENs to, the console if empty")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("posgen: ")
+
+	if err := globals.SetGeometry(*files, *ranks); err != nil {
+		log.Fatalf("invalid board size: %v", err)
+	}
+	board.InitAttackTables()
+	board.InitRandomKeys()
+	if *arrangement != "random" {
+		index, err := board.ParseStartArrangement(*arrangement)
+		if err != nil {
+			log.Fatal(err)
+		}
+		options.Arrangement = index
+	}
+	generator, err := board.NewPositionGenerator(options)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var out io.Writer = os.Stdout
+	var file *os.File
+	if *output != "" {
+		if file, err = os.Create(*output); err != nil {
+			log.Fatal(err)
+		}
+		out = file
+	}
+	writer := bufio.NewWriter(out)
+	generated, failedDraws := 0, 0
+	for i := 0; i < *count && failedDraws < maxFailedDraws; i++ {
+		fen, err := generator.Next()
+		if err != nil {
+			log.Printf("position %d skipped: %v", i+1, err)
+			failedDraws++
+			continue
+		}
+		failedDraws = 0
+		fmt.Fprintln(writer, fen)
+		generated++
+	}
+	// the positions generated are kept even when posgen exits with an error below
+	if err := writer.Flush(); err != nil {
+		log.Fatal(err)
+	}
+	if file != nil {
+		if err := file.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if generated < *count {
+		log.Fatalf("generated %d of %d positions", generated, *count)
+	}
+}
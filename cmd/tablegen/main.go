@@ -0,0 +1,49 @@
+/*
+Tablegen searches the magic numbers of a board geometry, checks the slider attack tables built with them against the
+attacks generated on the fly, and writes the leaper, mask, magic number and slider attack tables as Go source.
+
+It is run by `go generate ./board`, which writes board/attack_tables.go for the default board:
+
+	go run zerginator/cmd/tablegen -o attack_tables.go [-files 5] [-ranks 8]
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"log"
+	"os"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+func main() {
+	files := flag.Int("files", globals.DefaultFiles, "number of files on the board")
+	ranks := flag.Int("ranks", globals.DefaultRanks, "number of ranks on the board")
+	output := flag.String("o", "attack_tables.go", "file to write the tables to")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("tablegen: ")
+
+	if err := globals.SetGeometry(*files, *ranks); err != nil {
+		log.Fatalf("invalid board size: %v", err)
+	}
+	// build the tables from scratch, the generated tables being replaced are never loaded
+	board.InitLeapersAttacks()
+	board.InitMagicNumbers()
+	board.InitSlidersAttacks(globals.BISHOP)
+	board.InitSlidersAttacks(globals.ROOK)
+	if err := board.CheckSliderAttacks(); err != nil {
+		log.Fatalf("the magic numbers do not reproduce the on the fly attacks: %v", err)
+	}
+
+	var source bytes.Buffer
+	if err := board.WriteAttackTables(&source); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, source.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote the %dx%d tables to %s: %d bishop and %d rook attack entries", *files, *ranks, *output,
+		len(globals.BishopAttacks), len(globals.RookAttacks))
+}
@@ -35,7 +35,7 @@ const FenDebugStartPosition string = "ppppp/ppppp/ppppp/5/5/5/PPPPP/RNK1B w -"
 // ppppp/ppppp/ppppp/5/5/5/PPPPP/1BNKR w -
 const FenDebug2 string = "ppppp/ppp1p/p2p1/Ppppp/1P3/1RN1P/2PPB/2K2 w -"
 const FenDebug3 string = "ppppp/ppp1p/p2p1/PppNp/1P1P1/1R2P/2P1B/2K2 b d3"
-const FenDebug4 string = "1p3/2P11/PR3/3Pp/Pp3/2B2/12p1/1NP1K b a3"
+const FenDebug4 string = "1p3/2P2/PR3/3Pp/Pp3/2B2/3p1/1NP1K b a3"
 
 /* - - - - - - - - - - - - - - - - - - - - - - -
 |											   |
@@ -45,7 +45,7 @@ const FenDebug4 string = "1p3/2P11/PR3/3Pp/Pp3/2B2/12p1/1NP1K b a3"
 
 // Side to move constants
 const (
-	WHITE = iota
+	WHITE Color = iota
 	BLACK
 	BOTH
 )
@@ -58,10 +58,11 @@ const (
 
 // Piece constants
 const (
-	WhitePawn = iota
+	WhitePawn Piece = iota
 	WhiteKnight
 	WhiteBishop
 	WhiteRook
+	WhiteQueen
 	WhiteKing
 	BlackPawn
 	NoPiece
@@ -74,48 +75,26 @@ const (
 )
 
 // AsciiPieces is a constant that holds the ascii representation of each piece
-var AsciiPieces = [6]string{
+var AsciiPieces = [NoPiece]string{
 	" P", // WHITE_PAWN
 	" N", // WHITE_KNIGHT
 	" B", // WHITE_BISHOP
 	" R", // WHITE_ROOK
+	" Q", // WHITE_QUEEN
 	" K", // WHITE_KING
 	" p"} // BlackPawn
 
 // UnicodePieces is a constant that holds the Unicode representation of each piece, make sure you have a Dark theme
-var UnicodePieces = [7]string{"♟", "♞", "♝", "♜", "♚", "♙"}
+var UnicodePieces = [NoPiece + 1]string{"♟", "♞", "♝", "♜", "♛", "♚", "♙"}
 
 // ♜ ♞ ♝ ♛ ♚ ♟︎
 // ♙ ♖ ♘ ♗ ♕ ♔
 
-// ConvertAsciiToConstants is a map that converts the ascii representation of each piece to its constant
-var ConvertAsciiToConstants = map[rune]int{
-	'P': WhitePawn,
-	'N': WhiteKnight,
-	'B': WhiteBishop,
-	'R': WhiteRook,
-	'K': WhiteKing,
-	'p': BlackPawn}
-
-// ConvertConstantsToString is a map that converts the piece constant to its ascii representation
-var ConvertConstantsToString = map[int]string{
-	WhitePawn:   "P",
-	WhiteKnight: "N",
-	WhiteBishop: "B",
-	WhiteRook:   "R",
-	WhiteKing:   "K",
-	BlackPawn:   "p",
-	-1:          "1"}
-
-// PromotedPieces holds the map for promoted pieces constants
-var PromotedPieces = map[int]string{
-	WhiteKnight: "N",
-	WhiteBishop: "B",
-	WhiteRook:   "R",
-	WhiteKing:   "K"}
+// PromotedPieces holds the pieces a white pawn can promote to under the default rules, variants may change them
+var PromotedPieces = []Piece{WhiteKnight, WhiteBishop, WhiteRook, WhiteQueen, WhiteKing}
 
 /*
-constant representing each square on the 5x8 board
+constant representing each square on the default 5x8 board
 a8, b8, c8, d8, e8,
 a7, b7, c7, d7, e7,
 a6, b6, c6, d6, e6,
@@ -126,7 +105,7 @@ a2, b2, c2, d2, e2,
 a1, b1, c1, d1, e1
 */
 const (
-	A8 = iota
+	A8 Square = iota
 	B8
 	C8
 	D8
@@ -166,76 +145,10 @@ const (
 	C1
 	D1
 	E1
-	NoSquare
 )
 
-// MirrorSquare is a constant that holds the mirrored square for each square on the board
-var MirrorSquare = [40]int{
-	A1, B1, C1, D1, E1,
-	A2, B2, C2, D2, E2,
-	A3, B3, C3, D3, E3,
-	A4, B4, C4, D4, E4,
-	A5, B5, C5, D5, E5,
-	A6, B6, C6, D6, E6,
-	A7, B7, C7, D7, E7,
-	A8, B8, C8, D8, E8}
-
-// SquareToCoord is a constant that holds the algebraic notation for each square on the board
-var SquareToCoord = [40]string{
-	"a8", "b8", "c8", "d8", "e8",
-	"a7", "b7", "c7", "d7", "e7",
-	"a6", "b6", "c6", "d6", "e6",
-	"a5", "b5", "c5", "d5", "e5",
-	"a4", "b4", "c4", "d4", "e4",
-	"a3", "b3", "c3", "d3", "e3",
-	"a2", "b2", "c2", "d2", "e2",
-	"a1", "b1", "c1", "d1", "e1"}
-
-/*
-NotAFile is the bitboard for the file A with all ones except for the first file which is 0
-this can be created like this and run in the main function once to get the constant value:
-8   0  1  1  1  1
-7   0  1  1  1  1
-6   0  1  1  1  1
-5   0  1  1  1  1
-4   0  1  1  1  1
-3   0  1  1  1  1
-2   0  1  1  1  1
-1   0  1  1  1  1
-
-	A  B  C  D  E
-*/
-const NotAFile uint64 = 1064043510750
-const NotEFile uint64 = 532021755375
-const NotDEFile uint64 = 248276819175
-const NotABFile uint64 = 993107276700
-
-// Bitboard40Mask is a constant that masks the last 24 bits of a 64-bit integer
-const Bitboard40Mask uint64 = (1 << 40) - 1
-
-// SideToMove is a constant that holds the side to move
-var SideToMove int
-
-// EnPassantSquare is a constant that holds the square of the en passant target
-var EnPassantSquare int = NoSquare
-
-// Bitboards holds the bitboards for each piece: black has only pawns, white has pawns, knight, bishop and rook, king
-var Bitboards [6]uint64
-
-// Occupancies hold the occupancy of each square
-var Occupancies [3]uint64
-
-// HashKey holds the hash key for the current position
-var HashKey uint64
-
-// RepetitionTable holds the repetition table for the current position
-var RepetitionTable [10000]uint64
-
-// RepetitionIndex holds the repetition index for the current position
-var RepetitionIndex int
-
-// Ply is the current ply in the search tree
-var Ply int
+// NoSquare is the square constant used when no square is set, it lies outside any board
+const NoSquare Square = MaxSquares
 
 /* - - - - - - - - - - - - - - - - - - - - - - -
 |											   |
@@ -244,133 +157,59 @@ var Ply int
 - - - - - - - - - - - - - - - - - - - - - - - */
 
 // PawnAttacks Here we create a way to form the pawn attack table, which is a 2d array [side_to_move][square]
-var PawnAttacks [2][40]uint64
+var PawnAttacks [2][MaxSquares]Bitboard
 
 // KnightAttacks is a table of all the knight attacks on the bitboard
-var KnightAttacks [40]uint64
+var KnightAttacks [MaxSquares]Bitboard
 
 // KingAttacks is a table of all the king attacks on the bitboard
-var KingAttacks [40]uint64
+var KingAttacks [MaxSquares]Bitboard
 
 // BishopMasks is a table of all the bishop masks on the bitboard
-var BishopMasks [40]uint64
+var BishopMasks [MaxSquares]Bitboard
 
 // RookMasks is a table of all the rook masks on the bitboard
-var RookMasks [40]uint64
+var RookMasks [MaxSquares]Bitboard
+
+/*
+	BishopAttacks and RookAttacks hold the slider attacks of every square in one contiguous table per slider.
+	Each square only needs 2^relevant-bits entries, so the squares are packed one after the other and a square
+	finds its entries at its offset in the table:
+		attacks = RookAttacks[RookAttackOffsets[square] + (occupancy & RookMasks[square]) * magic >> RookShifts[square]]
+	The tables are loaded from the generated tables or built by InitSlidersAttacks, see board.InitAttackTables.
+*/
 
 // BishopAttacks is a table of all the bishop attacks on the bitboard
-var BishopAttacks [40][512]uint64
+var BishopAttacks []Bitboard
 
 // RookAttacks is a table of all the rook attacks on the bitboard
-var RookAttacks [40][4096]uint64
+var RookAttacks []Bitboard
+
+// BishopAttackOffsets holds the index of the first entry of each square in BishopAttacks
+var BishopAttackOffsets [MaxSquares]int
+
+// RookAttackOffsets holds the index of the first entry of each square in RookAttacks
+var RookAttackOffsets [MaxSquares]int
+
+// BishopShifts holds the shift that turns the magic product into an index, 64 minus the relevant occupancy count
+var BishopShifts [MaxSquares]uint
+
+// RookShifts holds the shift that turns the magic product into an index, 64 minus the relevant occupancy count
+var RookShifts [MaxSquares]uint
 
 // BishopRelevantOccupancyCount are the relevant occupancy bit count for every square on the board
-var BishopRelevantOccupancyCount = [40]int{
-	3, 2, 2, 2, 3,
-	3, 2, 2, 2, 3,
-	4, 3, 4, 3, 4,
-	5, 4, 4, 4, 5,
-	5, 4, 4, 4, 5,
-	4, 3, 4, 3, 4,
-	3, 2, 2, 2, 3,
-	3, 2, 2, 2, 3}
+var BishopRelevantOccupancyCount [MaxSquares]int
 
 // RookRelevantOccupancyCount are the relevant occupancy bit count for every square on the board
-var RookRelevantOccupancyCount = [40]int{
-	9, 8, 8, 8, 9,
-	8, 7, 7, 7, 8,
-	8, 7, 7, 7, 8,
-	8, 7, 7, 7, 8,
-	8, 7, 7, 7, 8,
-	8, 7, 7, 7, 8,
-	8, 7, 7, 7, 8,
-	9, 8, 8, 8, 9}
-
-// RookMagicNumbers RooKMagicNumbers is a table of all the rook magic numbers
-var RookMagicNumbers = [40]uint64{
-	0x400804082801400,
-	0xa0081028000084d,
-	0x240800c0400420a0,
-	0x402004040000092,
-	0x800a04400204082,
-	0x20480100000890,
-	0x10201008000030,
-	0x20404040482000,
-	0x340200404420001,
-	0x2160048090020201,
-	0x421002004400040,
-	0x80801008000806,
-	0x4421020040041044,
-	0xc80844020000600,
-	0x4002480900000,
-	0x8020081100600000,
-	0x100482010000001,
-	0x4080204104042000,
-	0x8140100804000000,
-	0x8408200120880300,
-	0x40100240110a000c,
-	0x120804081001904,
-	0x20204080160000,
-	0xc0408040400101,
-	0x420044020001020,
-	0x40040210000a00b,
-	0x8050101080800,
-	0x882081080022010,
-	0x208200420190800,
-	0xc008448800800185,
-	0x4010480a0000080,
-	0x2100810500004c1,
-	0x2008080810400620,
-	0x431010480040000,
-	0x8048a40000080,
-	0x8804002800002,
-	0x10010080408000,
-	0x10020202040a004,
-	0x8020200400800001,
-	0x11048082002040}
-
-// BishopMagicNumbers is a table of all the bishop magic numbers
-var BishopMagicNumbers = [40]uint64{
-	0x25c080804200101,
-	0x895284800000b20,
-	0x24a040000000442,
-	0x30c000080408000,
-	0x48200004002020,
-	0x8110888004010,
-	0x1404640080008810,
-	0xccc01088b5106,
-	0x6822020000500,
-	0x80b1428200040040,
-	0x110222100083402,
-	0x10010b501000001,
-	0x80108900002020,
-	0x481143100060020,
-	0x4084314800000000,
-	0x80c8104240048100,
-	0x84008100021000,
-	0x44008a05000100,
-	0x480c910801c210,
-	0xe440801080008602,
-	0x208201010800004,
-	0x109089020020100,
-	0x200208400540a80,
-	0x2088408240206206,
-	0x424080811414000,
-	0x30c20c40440000,
-	0x6000108091020900,
-	0x4010822040018008,
-	0x3212888242040002,
-	0x809001002034c,
-	0x60008d2020043210,
-	0x830404b40c350241,
-	0x8801854800842,
-	0x8021320600810,
-	0x14e0200041004,
-	0x501259044204a0,
-	0x84a4400840,
-	0x9c0028130025001,
-	0x80000108c001010,
-	0x4329c40856000904}
+var RookRelevantOccupancyCount [MaxSquares]int
+
+// RookMagicNumbers holds the rook magic number of every square, they are copied from the generated tables on the
+// default board and searched by InitMagicNumbers on other boards
+var RookMagicNumbers [MaxSquares]uint64
+
+// BishopMagicNumbers holds the bishop magic number of every square, they are copied from the generated tables on the
+// default board and searched by InitMagicNumbers on other boards
+var BishopMagicNumbers [MaxSquares]uint64
 
 /* - - - - - - - - - - - - - - - - - - - - - - -
 |											   |
@@ -378,17 +217,20 @@ var BishopMagicNumbers = [40]uint64{
 |											   |
 - - - - - - - - - - - - - - - - - - - - - - - */
 
+// The positional values are written for the default board, other board sizes look them up through ReferenceSquare
+
 // MaterialValues holds the material values for each piece type
-var MaterialValues = map[int]int{
+var MaterialValues = map[Piece]int{
 	WhitePawn:   100,
 	WhiteKnight: 300,
 	WhiteBishop: 350,
 	WhiteRook:   500,
+	WhiteQueen:  900,
 	WhiteKing:   400,
 	BlackPawn:   -100}
 
 // PawnPositionalValues holds the positional values for pawns on the board
-var PawnPositionalValues = [40]int{
+var PawnPositionalValues = [DefaultFiles * DefaultRanks]int{
 	90, 90, 90, 90, 90,
 	30, 30, 40, 30, 30,
 	20, 20, 30, 20, 20,
@@ -399,7 +241,7 @@ var PawnPositionalValues = [40]int{
 	0, 0, 0, 0, 0}
 
 // KnightPositionalValues holds the positional values for knights on the board
-var KnightPositionalValues = [40]int{
+var KnightPositionalValues = [DefaultFiles * DefaultRanks]int{
 	-5, 0, 0, 0, -5,
 	-5, 0, 5, 0, -5,
 	-5, 5, 10, 5, -5,
@@ -410,7 +252,7 @@ var KnightPositionalValues = [40]int{
 	-5, -5, -5, -5, -5}
 
 // BishopPositionalValues holds the positional values for bishop on the board
-var BishopPositionalValues = [40]int{
+var BishopPositionalValues = [DefaultFiles * DefaultRanks]int{
 	0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0,
 	0, 10, 10, 10, 0,
@@ -421,7 +263,7 @@ var BishopPositionalValues = [40]int{
 	-10, -10, -10, -10, -10}
 
 // RookPositionalValues holds the positional values for rook on the board
-var RookPositionalValues = [40]int{
+var RookPositionalValues = [DefaultFiles * DefaultRanks]int{
 	50, 50, 50, 50, 50,
 	50, 50, 50, 50, 50,
 	0, 10, 20, 10, 0,
@@ -431,8 +273,19 @@ var RookPositionalValues = [40]int{
 	0, 10, 20, 10, 0,
 	0, 0, 20, 0, 0}
 
+// QueenPositionalValues holds the positional values for queen on the board
+var QueenPositionalValues = [DefaultFiles * DefaultRanks]int{
+	20, 20, 20, 20, 20,
+	20, 20, 20, 20, 20,
+	0, 10, 20, 10, 0,
+	0, 10, 20, 10, 0,
+	0, 10, 20, 10, 0,
+	0, 5, 10, 5, 0,
+	0, 5, 10, 5, 0,
+	-10, -5, 0, -5, -10}
+
 // KingPositionalValues holds the positional values for king on the board
-var KingPositionalValues = [40]int{
+var KingPositionalValues = [DefaultFiles * DefaultRanks]int{
 	0, 0, 0, 0, 0,
 	0, 5, 5, 5, 0,
 	0, 5, 10, 5, 0,
@@ -442,17 +295,6 @@ var KingPositionalValues = [40]int{
 	0, 5, -5, 5, 0,
 	-15, -15, -15, -15, -15}
 
-// GetRankFromSquare holds the rank for each square on the board
-var GetRankFromSquare = [40]int{
-	7, 7, 7, 7, 7,
-	6, 6, 6, 6, 6,
-	5, 5, 5, 5, 5,
-	4, 4, 4, 4, 4,
-	3, 3, 3, 3, 3,
-	2, 2, 2, 2, 2,
-	1, 1, 1, 1, 1,
-	0, 0, 0, 0, 0}
-
 // DoublePawnPenalty holds the doubled pawn penalty
 var DoublePawnPenalty = -10
 
@@ -469,19 +311,35 @@ const OpenFileScore = 15
 var PassedPawnBonus = [8]int{0, 5, 10, 20, 35, 60, 100, 200}
 
 // FileMasks holds the file masks for each file on the board
-var FileMasks [40]uint64
+var FileMasks [MaxSquares]Bitboard
 
 // RankMasks holds the rank masks for each rank on the board
-var RankMasks [40]uint64
+var RankMasks [MaxSquares]Bitboard
 
 // IsolatedMasks holds the isolated pawn masks for each file on the board
-var IsolatedMasks [40]uint64
+var IsolatedMasks [MaxSquares]Bitboard
 
 // WhitePassedMasks holds the passed white pawn masks for each file on the board
-var WhitePassedMasks [40]uint64
+var WhitePassedMasks [MaxSquares]Bitboard
 
 // BlackPassedMasks holds the passed black pawn masks for each file on the board
-var BlackPassedMasks [40]uint64
+var BlackPassedMasks [MaxSquares]Bitboard
+
+/* - - - - - - - - - - - - - - - - - - - - - - -
+|											   |
+				DRAW RULES
+|											   |
+- - - - - - - - - - - - - - - - - - - - - - - */
+
+// DefaultNoProgressLimit is the default number of moves in a row without a pawn move or capture that draws the game
+const DefaultNoProgressLimit = 100
+
+// NoProgressLimit is the number of moves in a row, counted for both sides, without a pawn move or capture that draws
+// the game, 0 turns the rule off. It is chosen at startup with the -noprogress flag
+var NoProgressLimit = DefaultNoProgressLimit
+
+// RepetitionLimit is the number of times the same position must occur to draw the game
+const RepetitionLimit = 3
 
 /* - - - - - - - - - - - - - - - - - - - - - - -
 |											   |
@@ -492,12 +350,6 @@ var BlackPassedMasks [40]uint64
 // Scanner is used to read input from the console
 var Scanner = bufio.NewScanner(os.Stdin)
 
-// NodesVisited counts the number of nodes visited during perft tests
-var NodesVisited int
-
-// LeafNodesVisited counts the number of leaf nodes visited during perft tests
-var LeafNodesVisited int
-
 // TotalNodesEveryPlyFromStart holds the total number of nodes at each depth from 1 to 15 from the initial position
 var TotalNodesEveryPlyFromStart = [15]int{13, 78, 986, 6530, 88362, 654080, 9370535, 75313428, 1126242061, 9632104995, 149071825095, 12, 13, 14, 15}
 
@@ -0,0 +1,150 @@
+package globals
+
+import (
+	"fmt"
+	"strconv"
+)
+
+/* - - - - - - - - - - - - - - - - - - - - - - -
+|											   |
+				GEOMETRY CONSTANTS
+|											   |
+- - - - - - - - - - - - - - - - - - - - - - - */
+
+// MaxSquares is the largest number of squares a board can have, every square must fit in a 64-bit bitboard
+const MaxSquares = 64
+
+// Board dimension constants, the default board is the 5x8 horde board
+const (
+	DefaultFiles = 5
+	DefaultRanks = 8
+	MinFiles     = 5 // the bottom row arrangements hold five squares
+	MaxFiles     = 8
+	MinRanks     = 6 // three black pawn ranks, one empty rank, the white pawns and the white pieces
+	MaxRanks     = 8 // ranks are written as a single digit
+)
+
+// BoardGeometry describes the dimensions of the board. Squares are numbered row by row from the top left corner,
+// so on the default board a8 is square 0 and e1 is square 39. Ranks are counted as rows from the top of the board.
+type BoardGeometry struct {
+	Files int
+	Ranks int
+}
+
+// Squares returns the number of squares on the board, which is also the first square past the end of the board
+func (g BoardGeometry) Squares() Square {
+	return Square(g.Files * g.Ranks)
+}
+
+// Square returns the square on the given row (counted from the top) and file
+func (g BoardGeometry) Square(rank int, file int) Square {
+	return Square(rank*g.Files + file)
+}
+
+// Rank returns the row of the square counted from the top of the board
+func (g BoardGeometry) Rank(square Square) int {
+	return int(square) / g.Files
+}
+
+// File returns the file of the square
+func (g BoardGeometry) File(square Square) int {
+	return int(square) % g.Files
+}
+
+// IsDefault returns true if the geometry is the default 5x8 board
+func (g BoardGeometry) IsDefault() bool {
+	return g.Files == DefaultFiles && g.Ranks == DefaultRanks
+}
+
+// Geometry is the geometry of the board in play, it is chosen at startup with SetGeometry
+var Geometry BoardGeometry
+
+// BoardMask is the bitboard with every square of the board set
+var BoardMask Bitboard
+
+/*
+NotAFile is the bitboard with all ones except for the first file, NotEFile is the same for the last file.
+On the default board NotAFile looks like this:
+8   0  1  1  1  1
+7   0  1  1  1  1
+6   0  1  1  1  1
+5   0  1  1  1  1
+4   0  1  1  1  1
+3   0  1  1  1  1
+2   0  1  1  1  1
+1   0  1  1  1  1
+
+	A  B  C  D  E
+*/
+var NotAFile, NotEFile Bitboard
+
+// NotABFile masks out the first two files and NotDEFile the last two files
+var NotABFile, NotDEFile Bitboard
+
+// RowMasks holds the squares of each row of the board, rows are counted from the top like BoardGeometry.Rank
+var RowMasks [MaxRanks]Bitboard
+
+// MirrorSquare holds the square mirrored vertically, the same file on the opposite rank, for each square
+var MirrorSquare [MaxSquares]Square
+
+// MirrorFileSquare holds the square mirrored left to right, the same rank on the opposite file, for each square
+var MirrorFileSquare [MaxSquares]Square
+
+// SquareToCoord holds the algebraic notation for each square on the board
+var SquareToCoord [MaxSquares]string
+
+// GetRankFromSquare holds the rank for each square on the board, 0 being the white bottom row
+var GetRankFromSquare [MaxSquares]int
+
+// ReferenceSquare maps each square to the matching square of the default board, so that tables written for
+// the default board (such as the positional values) can be used on any board size
+var ReferenceSquare [MaxSquares]Square
+
+// SetGeometry sets the board dimensions and computes the geometry tables
+func SetGeometry(files int, ranks int) error {
+	if files < MinFiles || files > MaxFiles {
+		return fmt.Errorf("board must have between %d and %d files, got %d", MinFiles, MaxFiles, files)
+	}
+	if ranks < MinRanks || ranks > MaxRanks {
+		return fmt.Errorf("board must have between %d and %d ranks, got %d", MinRanks, MaxRanks, ranks)
+	}
+	Geometry = BoardGeometry{Files: files, Ranks: ranks}
+
+	if Geometry.Squares() == MaxSquares {
+		BoardMask = ^Bitboard(0)
+	} else {
+		BoardMask = (1 << Geometry.Squares()) - 1
+	}
+	NotAFile, NotEFile, NotABFile, NotDEFile = 0, 0, 0, 0
+	RowMasks = [MaxRanks]Bitboard{}
+	for square := Square(0); square < Geometry.Squares(); square++ {
+		rank, file := Geometry.Rank(square), Geometry.File(square)
+		if file != 0 {
+			NotAFile |= 1 << square
+		}
+		if file != files-1 {
+			NotEFile |= 1 << square
+		}
+		if file > 1 {
+			NotABFile |= 1 << square
+		}
+		if file < files-2 {
+			NotDEFile |= 1 << square
+		}
+		RowMasks[rank] |= 1 << square
+		MirrorSquare[square] = Geometry.Square(ranks-1-rank, file)
+		MirrorFileSquare[square] = Geometry.Square(rank, files-1-file)
+		SquareToCoord[square] = string(rune('a'+file)) + strconv.Itoa(ranks-rank)
+		GetRankFromSquare[square] = ranks - 1 - rank
+		// scale the row and file onto the default board, rounding to the nearest square
+		referenceRank := (rank*(DefaultRanks-1)*2 + ranks - 1) / ((ranks - 1) * 2)
+		referenceFile := (file*(DefaultFiles-1)*2 + files - 1) / ((files - 1) * 2)
+		ReferenceSquare[square] = Square(referenceRank*DefaultFiles + referenceFile)
+	}
+	return nil
+}
+
+func init() {
+	// the default board is always valid
+	_ = SetGeometry(DefaultFiles, DefaultRanks)
+}
@@ -0,0 +1,115 @@
+package globals
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/* - - - - - - - - - - - - - - - - - - - - - - -
+|											   |
+				BOARD TYPES
+|											   |
+- - - - - - - - - - - - - - - - - - - - - - - */
+
+// Color is a side of the game: WHITE, BLACK, or BOTH when indexing the occupancies
+type Color int
+
+// Piece is one of the piece constants, from WhitePawn to BlackPawn
+type Piece int
+
+// Square is the index of a square on the board, see BoardGeometry for the numbering
+type Square int
+
+// Bitboard is a set of squares, bit n is set when square n is in the set
+type Bitboard uint64
+
+// String returns the name of the side
+func (c Color) String() string {
+	switch c {
+	case WHITE:
+		return "white"
+	case BLACK:
+		return "black"
+	case BOTH:
+		return "both"
+	}
+	return "Color(" + strconv.Itoa(int(c)) + ")"
+}
+
+// ParseColor converts the side to move field of a FEN string ("w" or "b") to a Color
+func ParseColor(s string) (Color, error) {
+	switch s {
+	case "w":
+		return WHITE, nil
+	case "b":
+		return BLACK, nil
+	}
+	return BOTH, fmt.Errorf("invalid side to move %q", s)
+}
+
+// pieceLetters holds the FEN letter of each piece
+var pieceLetters = [NoPiece]string{"P", "N", "B", "R", "Q", "K", "p"}
+
+// String returns the FEN letter of the piece, or "-" when there is no piece
+func (p Piece) String() string {
+	if p >= WhitePawn && p < NoPiece {
+		return pieceLetters[p]
+	}
+	return "-"
+}
+
+// Color returns the side the piece belongs to
+func (p Piece) Color() Color {
+	if p == BlackPawn {
+		return BLACK
+	}
+	return WHITE
+}
+
+// ParsePiece converts a FEN letter to a Piece
+func ParsePiece(ch rune) (Piece, error) {
+	for piece := WhitePawn; piece < NoPiece; piece++ {
+		if pieceLetters[piece] == string(ch) {
+			return piece, nil
+		}
+	}
+	return NoPiece, fmt.Errorf("invalid piece %q", ch)
+}
+
+// String returns the algebraic notation of the square, or "-" when it is not on the board
+func (sq Square) String() string {
+	if sq >= 0 && sq < Geometry.Squares() {
+		return SquareToCoord[sq]
+	}
+	return "-"
+}
+
+// ParseSquare converts the algebraic notation of a square (for example "c3") to a Square on the current board
+func ParseSquare(s string) (Square, error) {
+	if len(s) != 2 {
+		return NoSquare, fmt.Errorf("invalid square %q", s)
+	}
+	file := int(s[0] - 'a')
+	rank := int(s[1] - '0')
+	if file < 0 || file >= Geometry.Files || rank < 1 || rank > Geometry.Ranks {
+		return NoSquare, fmt.Errorf("square %q is not on the board", s)
+	}
+	return Geometry.Square(Geometry.Ranks-rank, file), nil
+}
+
+// Bit returns the bitboard with only the square set
+func (sq Square) Bit() Bitboard {
+	return 1 << sq
+}
+
+// String returns the squares of the bitboard separated by spaces
+func (b Bitboard) String() string {
+	var squares []string
+	for square := Square(0); square < Geometry.Squares(); square++ {
+		if b&square.Bit() != 0 {
+			squares = append(squares, square.String())
+		}
+	}
+	return "{" + strings.Join(squares, " ") + "}"
+}
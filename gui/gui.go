@@ -6,8 +6,8 @@ import (
 	"image/png"
 	"log"
 	"os"
+	"strings"
 	"time"
-	"zerginator/ai"
 	"zerginator/bitoperations"
 	"zerginator/board"
 	"zerginator/clock"
@@ -22,15 +22,20 @@ import (
 )
 
 const (
-	tileSize     = 80
-	boardWidth   = 5
-	boardHeight  = 8
-	panelHeight  = 110
-	ScreenWidth  = tileSize * boardWidth
-	ScreenHeight = tileSize*boardHeight + panelHeight
-	panelY       = tileSize * boardHeight
-	ctrlBtnW     = 150
-	ctrlBtnH     = 40
+	tileSize       = 80
+	panelHeight    = 110
+	ctrlBtnW       = 150
+	ctrlBtnH       = 40
+	bottomRowSlots = 5 // number of squares in a bottom row arrangement
+)
+
+// board and screen dimensions, set from the board geometry by InitImages
+var (
+	boardWidth   int
+	boardHeight  int
+	ScreenWidth  int
+	ScreenHeight int
+	panelY       int
 )
 
 const (
@@ -48,7 +53,7 @@ var (
 	checkboxOnImg  *ebiten.Image
 	checkboxOffImg *ebiten.Image
 	buttonImg      *ebiten.Image
-	scaledPieceImg map[int]*ebiten.Image
+	scaledPieceImg map[globals.Piece]*ebiten.Image
 )
 
 // loadPNG loads a PNG image from the specified path and returns it as an *ebiten.Image
@@ -65,10 +70,14 @@ func loadPNG(path string) (*ebiten.Image, error) {
 	return ebiten.NewImageFromImage(img), nil
 }
 
-// InitImages initializes the piece images and the reusable UI assets.
+// InitImages initializes the screen layout for the board geometry, the piece images and the reusable UI assets.
 // Scales piece images to tileSize once to avoid per-frame scaling.
 func InitImages() error {
-	scaledPieceImg = make(map[int]*ebiten.Image)
+	boardWidth, boardHeight = globals.Geometry.Files, globals.Geometry.Ranks
+	ScreenWidth = tileSize * boardWidth
+	ScreenHeight = tileSize*boardHeight + panelHeight
+	panelY = tileSize * boardHeight
+	scaledPieceImg = make(map[globals.Piece]*ebiten.Image)
 
 	// create reusable square images
 	lightSquareImg = ebiten.NewImage(tileSize, tileSize)
@@ -87,11 +96,12 @@ func InitImages() error {
 	buttonImg.Fill(color.RGBA{R: 50, G: 100, B: 200, A: 255})
 
 	// load raw images
-	paths := map[int]string{
+	paths := map[globals.Piece]string{
 		globals.WhitePawn:   "images/white_pawn.png",
 		globals.WhiteRook:   "images/white_rook.png",
 		globals.WhiteKnight: "images/white_knight.png",
 		globals.WhiteBishop: "images/white_bishop.png",
+		globals.WhiteQueen:  "images/white_queen.png",
 		globals.WhiteKing:   "images/white_king.png",
 		globals.BlackPawn:   "images/black_pawn.png",
 	}
@@ -114,6 +124,26 @@ func InitImages() error {
 	return nil
 }
 
+// bottomRowSquare returns the square of the given bottom row arrangement slot, the arrangement is centred on the board
+func bottomRowSquare(slot int) globals.Square {
+	return globals.Geometry.Square(boardHeight-1, (boardWidth-bottomRowSlots)/2+slot)
+}
+
+// startFEN returns the FEN of the start position with the chosen bottom row pieces, set up on a board with the pawns
+// of the start position only
+func startFEN(selection []globals.Piece) (string, error) {
+	setup := board.NewPosition()
+	if err := setup.ParseFEN(board.StartPosFEN("")); err != nil {
+		return "", err
+	}
+	for slot, piece := range selection {
+		if piece != globals.NoPiece {
+			setup.PutPiece(piece, bottomRowSquare(slot))
+		}
+	}
+	return setup.ToFEN(), nil
+}
+
 func formatSeconds(s time.Duration) string {
 	if s < 0 {
 		s = 0
@@ -124,27 +154,78 @@ func formatSeconds(s time.Duration) string {
 
 // Game represents a game state.
 type Game struct {
-	selectedSource  int
-	state           int
-	pvp             bool
-	pvc             bool
-	cvc             bool
-	playerPlays     int
-	movesMade       int
-	pieceOptions    []int
-	bottomSelection []int
-	winner          int
-	clock           *clock.GameClock
+	selectedSource   globals.Square
+	state            int
+	pvp              bool
+	pvc              bool
+	cvc              bool
+	playerPlays      globals.Color
+	movesMade        int
+	pieceOptions     []globals.Piece
+	bottomSelection  []globals.Piece
+	result           board.GameResult
+	reason           board.ResultReason
+	startArrangement int
+	seed             uint64 // the seed of the start arrangement of the next game
+	clock            *clock.GameClock
+	pos              *board.Position
+}
+
+// NewGame returns a game that starts in the menu with an empty board. The start arrangement of the first game is
+// picked from the seed and of every game after it from the next seed, a seed of 0 is taken from the clock instead
+func NewGame(seed uint64) *Game {
+	if seed == 0 {
+		seed = uint64(time.Now().UnixNano())
+	}
+	return &Game{
+		selectedSource: globals.NoSquare,
+		state:          stateMenu,
+		seed:           seed,
+		pos:            board.NewPosition(),
+	}
+}
+
+// loadPosition sets up the board from the FEN, logging the problems found in it. It returns false and keeps the
+// board as it was if the FEN is malformed or the position impossible
+func (g *Game) loadPosition(fen string) bool {
+	problems, err := g.pos.LoadFEN(fen)
+	for _, problem := range problems {
+		log.Printf("Position %q: %s", fen, problem)
+	}
+	if err != nil {
+		log.Printf("Cannot set position %q: %v", fen, err)
+		return false
+	}
+	return true
+}
+
+// newStartArrangement picks the start arrangement of a new game at random from the game's seed, and logs the
+// arrangement and the seed so the game can be set up again by starting the GUI with that seed
+func (g *Game) newStartArrangement() {
+	g.startArrangement = board.RandomStartArrangement(g.seed)
+	log.Printf("Start arrangement %d %s, seed %d", g.startArrangement,
+		globals.FenStartWhiteBottomRow[g.startArrangement], g.seed)
+	g.seed++
+}
+
+// loadStartPosition sets up the start position of the game's start arrangement
+func (g *Game) loadStartPosition() bool {
+	fen, err := board.StartPositionFEN(g.startArrangement)
+	if err != nil {
+		log.Printf("Cannot set up the start position: %v", err)
+		return false
+	}
+	return g.loadPosition(fen)
 }
 
 // numClicks tracks the number of clicks (0, 1, or 2)
 var numClicks int
 
 // pendingPromotionFrom is the square from which the piece is promoted from
-var pendingPromotionFrom int = globals.NoSquare
+var pendingPromotionFrom = globals.NoSquare
 
 // pendingPromotionTo is the square to which the piece is promoted to
-var pendingPromotionTo int = globals.NoSquare
+var pendingPromotionTo = globals.NoSquare
 
 // Update updates the game state.
 func (g *Game) Update() error {
@@ -257,51 +338,30 @@ func (g *Game) Update() error {
 					numClicks = 0
 					g.clock = clock.NewGameClock()
 					log.Println("Game started (from menu)")
-					board.ParseFEN(board.GetStartPosFEN())
-					board.PrintBoard()
+					g.newStartArrangement()
+					g.loadStartPosition()
+					g.pos.PrintBoard()
 				}
 			}
 		}
 	// Playing state: handle game interactions
 	case statePlaying:
-		// Check Win conditions
-		// Black side wins if one of its pawns is in the 1st rank
-		for sq := globals.A1; sq <= globals.E1; sq++ {
-			if bitoperations.GetBit(globals.Bitboards[globals.BlackPawn], sq) == 1 {
-				g.winner = globals.BLACK
-				g.state = stateGameOver
-				return nil
-			}
-		}
-		// Black side wins if all white pieces are captured
-		if globals.Occupancies[globals.WHITE] == 0 {
-			g.winner = globals.BLACK
-			g.state = stateGameOver
-			return nil
-		}
-		// White side wins if all black pieces are captured
-		if globals.Bitboards[globals.BlackPawn] == 0 {
-			g.winner = globals.WHITE
-			g.state = stateGameOver
-			return nil
-		}
-		tempMovesList := board.Moves{}
-		board.GenerateMoves(&tempMovesList)
-		if tempMovesList.Count == 0 {
-			g.winner = 3
+		// Check if the game is over
+		if result, reason := g.pos.Result(); result != board.Ongoing {
+			g.result, g.reason = result, reason
 			g.state = stateGameOver
 			return nil
 		}
 		if g.clock != nil {
 			if g.clock.White.IsExpired() {
 				log.Println("White side clock expired")
-				g.winner = globals.BLACK
+				g.result, g.reason = board.BlackWins, board.TimeForfeit
 				g.state = stateGameOver
 				break
 			}
 			if g.clock.Black.IsExpired() {
 				log.Println("Black side clock expired")
-				g.winner = globals.WHITE
+				g.result, g.reason = board.WhiteWins, board.TimeForfeit
 				g.state = stateGameOver
 				break
 			}
@@ -311,13 +371,13 @@ func (g *Game) Update() error {
 			x, y := ebiten.CursorPosition()
 			file := x / tileSize
 			rank := y / tileSize
-			square := rank*boardWidth + file
+			square := globals.Geometry.Square(rank, file)
 
 			// check if the player clicked the "Reset Game" button
 			btn1X := (ScreenWidth-100)/2 - 140
 			btn1Y := panelY + (panelHeight-ctrlBtnH)/2 - 10
 			if x >= btn1X && x <= btn1X+100 && y >= btn1Y && y <= btn1Y+ctrlBtnH {
-				board.ParseFEN(board.GetStartPosFEN())
+				g.loadStartPosition()
 				g.movesMade = 0
 				g.selectedSource = globals.NoSquare
 				g.clock = clock.NewGameClock()
@@ -328,7 +388,7 @@ func (g *Game) Update() error {
 			btn2Y := panelY + (panelHeight-ctrlBtnH)/2 - 10
 			if x >= btn2X && x <= btn2X+100 && y >= btn2Y && y <= btn2Y+ctrlBtnH {
 				if g.movesMade > 0 {
-					board.UnMakeMove()
+					g.pos.UnMakeMove()
 					g.movesMade--
 				}
 			}
@@ -345,27 +405,27 @@ func (g *Game) Update() error {
 				g.pvc = false
 			}
 
-			if g.pvp || (g.pvc && globals.SideToMove == g.playerPlays) && square < 40 {
-				if bitoperations.GetBit(globals.Occupancies[globals.BOTH], square) == 1 && g.selectedSource == globals.NoSquare && numClicks == 0 {
+			if g.pvp || (g.pvc && g.pos.SideToMove == g.playerPlays) && square < globals.Geometry.Squares() {
+				if bitoperations.GetBit(g.pos.Occupancies[globals.BOTH], square) == 1 && g.selectedSource == globals.NoSquare && numClicks == 0 {
 					g.selectedSource = square
-					log.Printf("Clicked Source square: %s\n", globals.SquareToCoord[square])
+					log.Printf("Clicked Source square: %s\n", square)
 					numClicks++
-				} else if g.selectedSource != globals.NoSquare && numClicks == 1 && square < 40 {
+				} else if g.selectedSource != globals.NoSquare && numClicks == 1 && square < globals.Geometry.Squares() {
 					numClicks = 0
-					log.Printf("Clicked Target square: %s\n", globals.SquareToCoord[square])
-					if bitoperations.GetBit(globals.Bitboards[globals.WhitePawn], g.selectedSource) == 1 && square >= globals.A8 && square <= globals.E8 {
+					log.Printf("Clicked Target square: %s\n", square)
+					if bitoperations.GetBit(g.pos.Bitboards[globals.WhitePawn], g.selectedSource) == 1 && globals.Geometry.Rank(square) == 0 {
 						pendingPromotionFrom = g.selectedSource
 						pendingPromotionTo = square
 						g.state = statePromotion
 						return nil
 					}
-					moveString := globals.SquareToCoord[g.selectedSource] + globals.SquareToCoord[square]
-					move := uci.ParseMove(moveString)
-					if move != 0 {
-						if board.MakeMove(move, globals.AllMoves) == 1 {
+					moveString := g.selectedSource.String() + square.String()
+					move := uci.ParseMove(g.pos, moveString)
+					if move != board.NoMove {
+						if g.pos.MakeMove(move, globals.AllMoves) == 1 {
 							g.clock.SwitchTurn()
 							g.movesMade++
-							board.PrintBoard()
+							g.pos.PrintBoard()
 							g.clock.Status()
 						}
 					}
@@ -375,13 +435,13 @@ func (g *Game) Update() error {
 			}
 		} else {
 			// Computer to make move if it's its turn
-			if (g.pvc && globals.SideToMove != g.playerPlays) || g.cvc {
-				uci.ParseGo("go depth 13")
+			if (g.pvc && g.pos.SideToMove != g.playerPlays) || g.cvc {
+				uci.ParseGo(g.pos, "go depth 13")
 				time.Sleep(1 * time.Second)
-				if board.MakeMove(ai.BestMove, globals.AllMoves) == 1 {
+				if g.pos.MakeMove(uci.Searcher.BestMove, globals.AllMoves) == 1 {
 					g.clock.SwitchTurn()
 					g.movesMade++
-					board.PrintBoard()
+					g.pos.PrintBoard()
 					g.clock.Status()
 				}
 			}
@@ -390,20 +450,13 @@ func (g *Game) Update() error {
 	case stateReset:
 		// initialize options and current selections when entering the reset state
 		if g.pieceOptions == nil {
-			g.pieceOptions = []int{-1, globals.WhiteKnight, globals.WhiteBishop, globals.WhiteRook, globals.WhiteKing}
+			g.pieceOptions = []globals.Piece{globals.NoPiece, globals.WhiteKnight, globals.WhiteBishop, globals.WhiteRook, globals.WhiteQueen, globals.WhiteKing}
 		}
 		if g.bottomSelection == nil || len(g.bottomSelection) == 0 {
-			count := 5
-			g.bottomSelection = make([]int, count)
+			count := bottomRowSlots
+			g.bottomSelection = make([]globals.Piece, count)
 			for i := 0; i < count; i++ {
-				g.bottomSelection[i] = -1
-				sq := globals.A1 // A1..E1 -> squares 0..4
-				for piece := range scaledPieceImg {
-					if bitoperations.GetBit(globals.Bitboards[piece], sq) == 1 {
-						g.bottomSelection[i] = piece
-						break
-					}
-				}
+				g.bottomSelection[i] = g.pos.PieceAt(bottomRowSquare(i))
 			}
 		}
 		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
@@ -414,7 +467,7 @@ func (g *Game) Update() error {
 			if x >= bx && x <= bx+btnW && y >= by && y <= by+btnH {
 				// make sure all the chosen pieces are unique
 				unique := true
-				seen := make(map[int]struct{})
+				seen := make(map[globals.Piece]struct{})
 				for _, val := range g.bottomSelection {
 					if _, exists := seen[val]; exists {
 						unique = false
@@ -423,10 +476,14 @@ func (g *Game) Update() error {
 				}
 				if unique {
 					// apply the new position
-					bottomRow := globals.ConvertConstantsToString[g.bottomSelection[0]] + globals.ConvertConstantsToString[g.bottomSelection[1]] + globals.ConvertConstantsToString[g.bottomSelection[2]] + globals.ConvertConstantsToString[g.bottomSelection[3]] + globals.ConvertConstantsToString[g.bottomSelection[4]]
-					fen := "ppppp/ppppp/ppppp/5/5/5/PPPPP/" + bottomRow + " w -"
-					board.ParseFEN(fen)
-					g.state = statePlaying
+					fen, err := startFEN(g.bottomSelection)
+					if err != nil {
+						log.Printf("Cannot set up the start position: %v", err)
+						return nil
+					}
+					if !g.loadPosition(fen) {
+						return nil
+					}
 					g.state = statePlaying
 					g.movesMade = 0
 					g.selectedSource = globals.NoSquare
@@ -437,7 +494,7 @@ func (g *Game) Update() error {
 				}
 			} else {
 				windW, windH := 60, 60
-				count := 5
+				count := bottomRowSlots
 				gap := (ScreenWidth - count*windW) / (count + 1)
 				baseY := (ScreenHeight-windH)/2 + 100
 				for i := 0; i < count; i++ {
@@ -475,9 +532,9 @@ func (g *Game) Update() error {
 			g.pvp = false
 			g.pvc = false
 			g.cvc = false
-			g.pieceOptions = make([]int, 0)
-			g.bottomSelection = make([]int, 0)
-			g.winner = 0
+			g.pieceOptions = make([]globals.Piece, 0)
+			g.bottomSelection = make([]globals.Piece, 0)
+			g.result, g.reason = board.Ongoing, board.NoReason
 			g.selectedSource = globals.NoSquare
 			g.movesMade = 0
 			g.playerPlays = 0
@@ -488,7 +545,7 @@ func (g *Game) Update() error {
 		if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
 			x, y := ebiten.CursorPosition()
 			windW, windH := 60, 60
-			count := 4
+			count := len(board.ActiveVariant.Promotions)
 			gap := (ScreenWidth - count*windW) / (count + 1)
 			baseY := (ScreenHeight-windH)/2 + 100
 
@@ -499,17 +556,17 @@ func (g *Game) Update() error {
 				return nil
 			}
 
-			for i := range globals.PromotedPieces {
-				bx := gap + (i-1)*(windW+gap)
+			for i, piece := range board.ActiveVariant.Promotions {
+				bx := gap + i*(windW+gap)
 				by := baseY
 				if x >= bx && x <= bx+windW && y >= by && y <= by+windH {
 					// map clicked index to promotion piece and uci promotion char
-					moveString := globals.SquareToCoord[pendingPromotionFrom] + globals.SquareToCoord[pendingPromotionTo] + globals.PromotedPieces[i]
-					move := uci.ParseMove(moveString)
-					if move != 0 {
-						if board.MakeMove(move, globals.AllMoves) == 1 {
+					moveString := pendingPromotionFrom.String() + pendingPromotionTo.String() + piece.String()
+					move := uci.ParseMove(g.pos, moveString)
+					if move != board.NoMove {
+						if g.pos.MakeMove(move, globals.AllMoves) == 1 {
 							g.movesMade++
-							board.PrintBoard()
+							g.pos.PrintBoard()
 						}
 					}
 					// clear pending promotion and reset selection
@@ -538,6 +595,7 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		screen.Fill(color.RGBA{R: 30, G: 30, B: 30, A: 255})
 
 		ebitenutil.DebugPrintAt(screen, "Zerginator - Menu", ScreenWidth/2-80, ScreenHeight/2-200)
+		ebitenutil.DebugPrintAt(screen, "Variant: "+board.ActiveVariant.Name, ScreenWidth/2-80, ScreenHeight/2-170)
 		ebitenutil.DebugPrintAt(screen, "Please check only one box!", ScreenWidth/2-80, ScreenHeight/2-140)
 
 		// draw checkboxes using pre-made images
@@ -654,10 +712,9 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		ebitenutil.DebugPrintAt(screen, "Press escape to go back (you will loose the order if you do)", ScreenWidth/2-170, ScreenHeight/2-50)
 
 		windW, windH := 60, 60
-		count := 5
+		count := bottomRowSlots
 		gap := (ScreenWidth - count*windW) / (count + 1)
 		baseY := (ScreenHeight-windH)/2 + 100
-		labels := []string{"A1", "B1", "C1", "D1", "E1"}
 
 		for i := 0; i < count; i++ {
 			x := gap + i*(windW+gap)
@@ -670,7 +727,7 @@ func (g *Game) Draw(screen *ebiten.Image) {
 			screen.DrawImage(box, opTemp)
 
 			// draw piece image if set, scale to fit the widget
-			if g.bottomSelection != nil && i < len(g.bottomSelection) && g.bottomSelection[i] != -1 {
+			if g.bottomSelection != nil && i < len(g.bottomSelection) && g.bottomSelection[i] != globals.NoPiece {
 				piece := g.bottomSelection[i]
 				if img := scaledPieceImg[piece]; img != nil {
 					imgOp := &ebiten.DrawImageOptions{}
@@ -682,7 +739,7 @@ func (g *Game) Draw(screen *ebiten.Image) {
 				}
 			}
 
-			ebitenutil.DebugPrintAt(screen, labels[i], x, baseY-20)
+			ebitenutil.DebugPrintAt(screen, strings.ToUpper(bottomRowSquare(i).String()), x, baseY-20)
 		}
 
 		btnX := (ScreenWidth - 200) / 2
@@ -696,14 +753,15 @@ func (g *Game) Draw(screen *ebiten.Image) {
 	} else if g.state == stateGameOver {
 		screen.Fill(color.RGBA{R: 30, G: 30, B: 30, A: 255})
 		var winnerText string
-		if g.winner == globals.WHITE {
+		if g.result == board.WhiteWins {
 			winnerText = "White Wins!"
-		} else if g.winner == globals.BLACK {
+		} else if g.result == board.BlackWins {
 			winnerText = "Black Wins!"
 		} else {
 			winnerText = "Draw!"
 		}
 		ebitenutil.DebugPrintAt(screen, winnerText, ScreenWidth/2-60, ScreenHeight/2-60)
+		ebitenutil.DebugPrintAt(screen, "by "+g.reason.String(), ScreenWidth/2-60, ScreenHeight/2-40)
 		//ebitenutil.DebugPrintAt(screen, fmt.Sprintf("Time left: %d seconds", g.timeLeft), ScreenWidth/2-80, ScreenHeight/2-20)
 		ebitenutil.DebugPrintAt(screen, "Click to return to menu", ScreenWidth/2-80, ScreenHeight/2+20)
 		return
@@ -714,12 +772,12 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		ebitenutil.DebugPrintAt(screen, "Press escape to go back", ScreenWidth/2-100, ScreenHeight/2-50)
 
 		windW, windH := 60, 60
-		count := 4
+		count := len(board.ActiveVariant.Promotions)
 		gap := (ScreenWidth - count*windW) / (count + 1)
 		baseY := (ScreenHeight-windH)/2 + 100
 
-		for i := globals.WhiteKnight; i <= globals.WhiteKing; i++ {
-			x := gap + (i-1)*(windW+gap)
+		for i, piece := range board.ActiveVariant.Promotions {
+			x := gap + i*(windW+gap)
 			opTemp := &ebiten.DrawImageOptions{}
 			opTemp.GeoM.Translate(float64(x), float64(baseY))
 
@@ -728,7 +786,7 @@ func (g *Game) Draw(screen *ebiten.Image) {
 			box.Fill(color.RGBA{R: 220, G: 220, B: 220, A: 255})
 			screen.DrawImage(box, opTemp)
 
-			if img := scaledPieceImg[i]; img != nil {
+			if img := scaledPieceImg[piece]; img != nil {
 				imgOp := &ebiten.DrawImageOptions{}
 				scale := float64(windW) / float64(tileSize)
 				imgOp.GeoM.Scale(scale, scale)
@@ -754,20 +812,17 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		}
 	}
 
-	// draw pieces by iterating piece types then squares (fewer allocations than per-tile inner loop)
-	for piece, img := range scaledPieceImg {
+	// draw the piece on each occupied square
+	for sq := globals.Square(0); sq < globals.Geometry.Squares(); sq++ {
+		img := scaledPieceImg[g.pos.PieceAt(sq)]
 		if img == nil {
 			continue
 		}
-		for sq := 0; sq < boardWidth*boardHeight; sq++ {
-			if bitoperations.GetBit(globals.Bitboards[piece], sq) == 1 {
-				file := sq % boardWidth
-				rank := sq / boardWidth
-				op := &ebiten.DrawImageOptions{}
-				op.GeoM.Translate(float64(file*tileSize), float64(rank*tileSize))
-				screen.DrawImage(img, op)
-			}
-		}
+		file := globals.Geometry.File(sq)
+		rank := globals.Geometry.Rank(sq)
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(file*tileSize), float64(rank*tileSize))
+		screen.DrawImage(img, op)
 	}
 
 	// panel background
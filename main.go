@@ -11,6 +11,8 @@ https://github.com/bluefeversoft/vice
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
 	"zerginator/ai"
 	"zerginator/board"
@@ -22,18 +24,47 @@ import (
 )
 
 func initAll() {
-	board.InitLeapersAttacks()
-	//game.InitMagicNumbers()
-	board.InitSlidersAttacks(globals.BISHOP)
-	board.InitSlidersAttacks(globals.ROOK)
+	board.InitAttackTables()
 	board.InitRandomKeys()
-	ai.ClearTranspositionTable()
 	ai.InitPawnEvaluationMasks()
 }
 
 func main() {
+	files := flag.Int("files", globals.DefaultFiles, "number of files on the board")
+	ranks := flag.Int("ranks", globals.DefaultRanks, "number of ranks on the board")
+	flag.IntVar(&globals.NoProgressLimit, "noprogress", globals.DefaultNoProgressLimit,
+		"moves without a pawn move or capture that draw the game, 0 for no limit")
+	seed := flag.Uint64("seed", 0,
+		"seed of the start arrangement of the first game in the GUI, 0 for a seed from the clock")
+	variantFile := flag.String("variant", "", "JSON file with the rules of the variant to play, the default rules if empty")
+	flag.Parse()
+	if err := globals.SetGeometry(*files, *ranks); err != nil {
+		log.Fatalf("invalid board size: %v", err)
+	}
+	if *variantFile != "" {
+		variant, err := board.LoadVariant(*variantFile)
+		if err == nil {
+			err = board.SetVariant(variant)
+		}
+		if err != nil {
+			log.Fatalf("invalid variant: %v", err)
+		}
+	}
 	initAll()
 
+	// "zerginator selftest" checks the tables, hashing, move generation and evaluation, and exits non-zero on the
+	// first failure
+	if flag.Arg(0) == "selftest" {
+		if err := board.SelfTest(); err != nil {
+			log.Fatalf("self test failed: %v", err)
+		}
+		if err := ai.SelfTest(); err != nil {
+			log.Fatalf("self test failed: %v", err)
+		}
+		fmt.Println("\tAll checks passed")
+		return
+	}
+
 	if err := gui.InitImages(); err != nil {
 		log.Fatalf("failed to load images: %v", err)
 	}
@@ -43,18 +74,21 @@ func main() {
 	if graphics {
 		ebiten.SetWindowSize(gui.ScreenWidth, gui.ScreenHeight)
 		ebiten.SetWindowTitle("Zerginator 1.0")
-		if err := ebiten.RunGame(&gui.Game{}); err != nil {
+		if err := ebiten.RunGame(gui.NewGame(*seed)); err != nil {
 			log.Fatal(err)
 		}
 	} else if debug {
-		board.ParseFEN(globals.FenDebugStartPosition)
-		//board.ParseFEN("5/p1ppp/5/5/5/5/P1PPP/1R3 w -")
-		board.PrintBoard()
-		//fmt.Println("\tScore: ", ai.EvaluatePosition())
-		uci.ParseGo("go depth 15")
-		//board.PrintBoard()
+		pos := board.NewPosition()
+		if err := pos.ParseFEN(globals.FenDebugStartPosition); err != nil {
+			log.Fatal(err)
+		}
+		//pos.ParseFEN("5/p1ppp/5/5/5/5/P1PPP/1R3 w -")
+		pos.PrintBoard()
+		//fmt.Println("\tScore: ", ai.EvaluatePosition(pos))
+		uci.ParseGo(pos, "go depth 15")
+		//pos.PrintBoard()
 		//for depth := 0; depth <= 0; depth++ {
-		//	moves.PerftTest(depth)
+		//	pos.PerftTest(depth)
 		//}
 	} else {
 		uci.MainUciLoop()
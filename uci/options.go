@@ -0,0 +1,112 @@
+package uci
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+/*
+	"position startpos" sets up the start arrangement of the game, so the move list a GUI sends again before every
+	move is always replayed on the same board. The arrangement is chosen with the UCI_StartArrangement option, as an
+	index from 0 to 119 or as a bottom row such as "RNK1B", or for a single command with "position startpos 37". The
+	value "random" picks a new arrangement for every game. The seed of the n-th game since StartSeed was set is
+	StartSeed+n, so a fixed seed still gives a different arrangement in every game. When StartSeed is 0 a seed is
+	taken from the clock once and kept as StartSeed. The arrangement and the seed of the game are reported, setting
+	StartSeed to that seed sets the game up again from its record.
+*/
+
+// StartArrangement is the index of the start arrangement of the current game
+var StartArrangement int
+
+// RandomStart makes every new game start from an arrangement picked at random
+var RandomStart bool
+
+// StartSeed is the seed of the random start arrangements, 0 takes a seed from the clock when the first one is picked
+var StartSeed uint64
+
+// gamesSinceSeed is the number of games started from StartSeed, it is added to the seed of the next game
+var gamesSinceSeed uint64
+
+// PrintOptions prints the options the engine supports in reply to the "uci" command
+func PrintOptions() {
+	fmt.Printf("option name UCI_StartArrangement type string default %d\n", StartArrangement)
+	fmt.Printf("option name StartSeed type string default %d\n", StartSeed)
+	fmt.Printf("option name VariantFile type string default <empty>\n")
+}
+
+// ParseSetOption parses the UCI "setoption name <id> value <x>" command and sets the option
+func ParseSetOption(command string) {
+	nameIndex, valueIndex := strings.Index(command, "name "), strings.Index(command, " value ")
+	if nameIndex == -1 || valueIndex == -1 || valueIndex < nameIndex {
+		fmt.Printf("info string invalid setoption command %q\n", command)
+		return
+	}
+	name := strings.TrimSpace(command[nameIndex+len("name ") : valueIndex])
+	value := strings.TrimSpace(command[valueIndex+len(" value "):])
+	switch name {
+	case "UCI_StartArrangement":
+		if value == "random" {
+			RandomStart = true
+			pickRandomStart()
+			return
+		}
+		index, err := board.ParseStartArrangement(value)
+		if err != nil {
+			fmt.Printf("info string %v\n", err)
+			return
+		}
+		RandomStart, StartArrangement = false, index
+	case "StartSeed":
+		seed, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			fmt.Printf("info string invalid start seed %q\n", value)
+			return
+		}
+		StartSeed, gamesSinceSeed = seed, 0
+	case "VariantFile":
+		// the empty value goes back to the default rules
+		variant := board.DefaultVariant()
+		if value != "" && value != "<empty>" {
+			var err error
+			if variant, err = board.LoadVariant(value); err != nil {
+				fmt.Printf("info string %v\n", err)
+				return
+			}
+		}
+		if err := board.SetVariant(variant); err != nil {
+			fmt.Printf("info string %v\n", err)
+			return
+		}
+		// the scores stored under the old rules no longer hold
+		Searcher.ClearTranspositionTable()
+		fmt.Printf("info string variant %s\n", variant.Name)
+	default:
+		fmt.Printf("info string unknown option %q\n", name)
+	}
+}
+
+// NewGame picks the start arrangement of a new game when the arrangements are random, and reports it with its seed
+func NewGame() {
+	if !RandomStart {
+		return
+	}
+	pickRandomStart()
+	gamesSinceSeed++
+}
+
+// pickRandomStart picks the start arrangement of the next game from its seed and reports both. It does not count the
+// game, so the arrangement picked when the option is set is the one of the game the next ucinewgame starts
+func pickRandomStart() {
+	if StartSeed == 0 {
+		// the seed from the clock is kept, so the reported arrangement is the one the next game starts from
+		StartSeed, gamesSinceSeed = uint64(time.Now().UnixNano()), 0
+	}
+	seed := StartSeed + gamesSinceSeed
+	StartArrangement = board.RandomStartArrangement(seed)
+	fmt.Printf("info string start arrangement %d %s seed %d\n",
+		StartArrangement, globals.FenStartWhiteBottomRow[StartArrangement], seed)
+}
@@ -0,0 +1,54 @@
+package uci
+
+import (
+	"testing"
+	"zerginator/board"
+)
+
+func TestNewGameAdvancesTheSeed(t *testing.T) {
+	defer func() { RandomStart, StartSeed, StartArrangement, gamesSinceSeed = false, 0, 0, 0 }()
+	ParseSetOption("setoption name StartSeed value 5")
+	ParseSetOption("setoption name UCI_StartArrangement value random")
+	arrangements := map[int]bool{}
+	for game := uint64(0); game < 4; game++ {
+		NewGame()
+		if expected := board.RandomStartArrangement(5 + game); StartArrangement != expected {
+			t.Errorf("game %d starts from arrangement %d, seed %d gives %d", game, StartArrangement, 5+game, expected)
+		}
+		arrangements[StartArrangement] = true
+	}
+	if len(arrangements) == 1 {
+		t.Errorf("every game starts from arrangement %d", StartArrangement)
+	}
+	// the reported seed of the third game sets it up again
+	ParseSetOption("setoption name StartSeed value 7")
+	NewGame()
+	if expected := board.RandomStartArrangement(7); StartArrangement != expected {
+		t.Errorf("seed 7 set up arrangement %d, the third game started from %d", StartArrangement, expected)
+	}
+}
+
+func TestUnseededGameStartsFromTheReportedArrangement(t *testing.T) {
+	defer func() { RandomStart, StartSeed, StartArrangement, gamesSinceSeed = false, 0, 0, 0 }()
+	ParseSetOption("setoption name StartSeed value 0")
+	ParseSetOption("setoption name UCI_StartArrangement value random")
+	seed, reported := StartSeed, StartArrangement
+	if seed == 0 {
+		t.Fatal("no seed was taken from the clock")
+	}
+	if expected := board.RandomStartArrangement(seed); reported != expected {
+		t.Errorf("reported arrangement %d, seed %d gives %d", reported, seed, expected)
+	}
+	for game := uint64(0); game < 3; game++ {
+		NewGame()
+		if expected := board.RandomStartArrangement(seed + game); StartArrangement != expected {
+			t.Errorf("game %d starts from arrangement %d, seed %d gives %d", game, StartArrangement, seed+game, expected)
+		}
+		if game == 0 && StartArrangement != reported {
+			t.Errorf("the first game starts from arrangement %d, %d was reported", StartArrangement, reported)
+		}
+	}
+	if StartSeed != seed {
+		t.Errorf("the seed changed from %d to %d", seed, StartSeed)
+	}
+}
@@ -14,64 +14,113 @@ import (
 // TimeKeeper is the game clock
 var TimeKeeper *clock.GameClock
 
+// Searcher searches the positions of the engine, its transposition table is kept from one search to the next
+var Searcher = ai.NewSearcher(ai.DefaultHashEntries)
+
+// Debug is switched by the UCI "debug on" and "debug off" commands, when on the board is sent after every position
+var Debug bool
+
+// printInfo sends the text line by line as info strings, so a GUI never reads a board diagram as a protocol message
+func printInfo(text string) {
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			fmt.Printf("info string %s\n", line)
+		}
+	}
+}
+
 // ParseMove takes a move in string format (e.g. "a2a4", "b7b8Q") and converts it to the internal move representation
-func ParseMove(moveString string) uint64 {
+func ParseMove(pos *board.Position, moveString string) board.Move {
+	if len(moveString) < 4 {
+		return board.NoMove
+	}
+	sourceSquare, err := globals.ParseSquare(moveString[0:2])
+	if err != nil {
+		return board.NoMove
+	}
+	targetSquare, err := globals.ParseSquare(moveString[2:4])
+	if err != nil {
+		return board.NoMove
+	}
 	moveList := board.Moves{}
-	board.GenerateMoves(&moveList)
-	sourceSquare := (moveString[0] - 'a') + (8-(moveString[1]-'0'))*5
-	targetSquare := (moveString[2] - 'a') + (8-(moveString[3]-'0'))*5
+	pos.GenerateMoves(&moveList)
 	for i := 0; i < moveList.Count; i++ {
 		move := moveList.Moves[i]
 		// make sure the source and target squares are available within the move list
-		if int(sourceSquare) == board.GetMoveSource(move) && int(targetSquare) == board.GetMoveTarget(move) {
-			promotedPiece := board.GetMovePromotedPiece(move)
+		if sourceSquare == move.Source() && targetSquare == move.Target() {
 			// if there is a promoted piece, make sure it matches the move string
-			if promotedPiece <= globals.WhiteKing && promotedPiece >= globals.WhiteKnight {
-				if len(moveString) == 5 {
-					if promotedPiece == globals.WhiteKnight && moveString[4] == 'N' {
-						return move
-					} else if promotedPiece == globals.WhiteBishop && moveString[4] == 'B' {
-						return move
-					} else if promotedPiece == globals.WhiteKing && moveString[4] == 'K' {
-						return move
-					} else if promotedPiece == globals.WhiteRook && moveString[4] == 'R' {
-						return move
-					}
+			if move.IsPromotion() {
+				if moveString[4:] == move.Promoted().String() {
+					return move
 				}
 				continue // continue loop if no legal move found
 			}
 			return move // legal move
 		}
 	}
-	return 0 // return illegal move
+	return board.NoMove // return illegal move
 }
 
 // ParsePosition sets up the board position based on the UCI "position" command
-func ParsePosition(command string) {
+func ParsePosition(pos *board.Position, command string) {
 	/*
 		This procedure sets up the board position based on the UCI "position" command.
 		It handles both the "startpos" and "fen" options to initialize the board state.
 		Examples of valid commands:
 		- "position startpos"
 		- "position startpos moves e2e4 e4e5 d2d4 b8c6"
+		- "position startpos 37 moves e2e4 e4e5" or "position startpos RNK1B", the start arrangement by index or name
+		- "position startpos RNKQB", any other bottom row, such as one with a queen
 		- "position fen ppppp/ppp1p/p2p1/Ppppp/1P3/1RN1P/2PPB/2K2 w -"
 		- "position fen ppppp/ppp1p/p2p1/Ppppp/1P3/1RN1P/2PPB/2K2 w - moves e2e4 e4e5 d2d4 b8c6"
 		- "position moves e2e4 e4e5 d2d4 b8c6"
 		- "position undo"
 	*/
-	currentChar := 9
-	command = command[currentChar:] // remove "position "
+	// remove "position ", a command without anything after it is malformed
+	arguments, found := strings.CutPrefix(command, "position ")
+	if arguments = strings.TrimSpace(arguments); !found || arguments == "" {
+		fmt.Printf("info string invalid position command %q\n", command)
+		return
+	}
+	command = arguments
+	var currentChar int
 	if strings.HasPrefix(command, "startpos") {
-		// initialize the board to the starting position
-		board.ParseFEN(board.GetStartPosFEN())
+		// initialize the board to the start arrangement named in the command, or else to the one of the game
+		arrangement := strings.TrimSpace(strings.TrimPrefix(command, "startpos"))
+		if currentChar = strings.Index(arrangement, "moves"); currentChar != -1 {
+			arrangement = strings.TrimSpace(arrangement[:currentChar])
+		}
+		index := StartArrangement
+		fen := ""
+		if arrangement != "" {
+			var err error
+			if index, err = board.ParseStartArrangement(arrangement); err != nil {
+				// any other bottom row, such as one with a queen, is set up without an index
+				if fen, _ = board.BottomRowFEN(arrangement); fen == "" {
+					fmt.Printf("info string %v\n", err)
+					return
+				}
+			}
+		}
+		if fen == "" {
+			var err error
+			if fen, err = board.StartPositionFEN(index); err != nil {
+				fmt.Printf("info string %v\n", err)
+				return
+			}
+		}
+		if !loadPosition(pos, fen) {
+			return
+		}
 	} else if strings.HasPrefix(command, "fen") {
-		// initialize the board to the given FEN
-		currentChar = strings.Index(command, "fen")
-		if currentChar == -1 {
-			board.ParseFEN(board.GetStartPosFEN())
-		} else {
-			currentChar += 4 // skip "fen "
-			board.ParseFEN(command[currentChar:])
+		// initialize the board to the given FEN, which ends where the moves start
+		fen := strings.TrimSpace(strings.TrimPrefix(command, "fen"))
+		if currentChar = strings.Index(fen, "moves"); currentChar != -1 {
+			fen = fen[:currentChar]
+		}
+		// a refused FEN leaves the board as it was and the moves are not played
+		if !loadPosition(pos, fen) {
+			return
 		}
 	}
 	// check for moves
@@ -81,27 +130,45 @@ func ParsePosition(command string) {
 		// loop over all moves in the command
 		for _, move := range strings.Split(command[currentChar:], " ") {
 			move = strings.TrimSpace(move)
-			parsedMove := ParseMove(move)
-			if parsedMove == 0 {
+			parsedMove := ParseMove(pos, move)
+			if parsedMove == board.NoMove {
 				break
 			}
-			globals.RepetitionIndex++
-			globals.RepetitionTable[globals.RepetitionIndex] = globals.HashKey
-			board.MakeMove(parsedMove, globals.AllMoves)
+			pos.MakeMove(parsedMove, globals.AllMoves)
 		}
 	}
 
 	// check for undo move
 	currentChar = strings.Index(command, "undo")
 	if currentChar != -1 {
-		if len(board.MoveStack) > 0 {
-			board.UnMakeMove()
+		if pos.MoveCount > 0 {
+			pos.UnMakeMove()
 		}
 	}
-	board.PrintBoard()
+	if Debug {
+		printInfo(pos.BoardString())
+	}
+	if result, reason := pos.Result(); result != board.Ongoing {
+		fmt.Printf("info string game over: %s by %s\n", result, reason)
+	}
+}
+
+// loadPosition sets up the position from the FEN and reports the problems found in it as info strings. It returns
+// false if the FEN is malformed or the position impossible, leaving the position as it was
+func loadPosition(pos *board.Position, fen string) bool {
+	problems, err := pos.LoadFEN(fen)
+	for _, problem := range problems {
+		fmt.Printf("info string %s\n", problem)
+	}
+	if err != nil {
+		fmt.Printf("info string position refused: %v\n", err)
+		return false
+	}
+	return true
 }
 
-func ParseGo(command string) {
+// ParseGo parses the UCI "go" command and searches the given position
+func ParseGo(pos *board.Position, command string) {
 	/*
 		This procedure parses the UCI "go" command to make the engine search for the best move. An example
 		command is "go depth 6".
@@ -118,13 +185,21 @@ func ParseGo(command string) {
 		// default depth
 		depth = 13
 	}
+	// a finished game has no move to search, 0000 is the UCI null move
+	if result, reason := pos.Result(); result != board.Ongoing {
+		fmt.Printf("info string game over: %s by %s\n", result, reason)
+		fmt.Printf("bestmove: 0000\n")
+		return
+	}
 	// search position
-	ai.SearchPosition(depth)
+	Searcher.SearchPosition(pos, depth)
 }
 
 // MainUciLoop is the main loop that handles UCI commands
 func MainUciLoop() {
 	var input string
+	// the position the engine is playing on
+	pos := board.NewPosition()
 	// main loop
 	fmt.Println("Zerginator 1.0")
 	for globals.Scanner.Scan() {
@@ -139,16 +214,26 @@ func MainUciLoop() {
 			fmt.Printf("readyok\n")
 			continue
 		case strings.HasPrefix(input, "position"):
-			ParsePosition(input)
-			ai.ClearTranspositionTable()
+			ParsePosition(pos, input)
+			Searcher.ClearTranspositionTable()
 		case strings.HasPrefix(input, "ucinewgame"):
-			ParsePosition("position startpos")
-			ai.ClearTranspositionTable()
+			NewGame()
+			ParsePosition(pos, "position startpos")
+			Searcher.ClearTranspositionTable()
 		case strings.HasPrefix(input, "go"):
-			ParseGo(input)
+			ParseGo(pos, input)
 		case strings.HasPrefix(input, "uci"):
 			fmt.Println("ID name: Zerginator 1.0")
+			PrintOptions()
 			fmt.Println("uciok")
+		case input == "d":
+			// the board and its FEN, on request only
+			printInfo(pos.BoardString())
+			printInfo("FEN: " + pos.ToFEN())
+		case strings.HasPrefix(input, "debug"):
+			Debug = strings.TrimSpace(strings.TrimPrefix(input, "debug")) == "on"
+		case strings.HasPrefix(input, "setoption"):
+			ParseSetOption(input)
 		case strings.HasPrefix(input, "startime"):
 			TimeKeeper = clock.NewGameClock()
 		case strings.HasPrefix(input, "quit"):
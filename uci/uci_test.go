@@ -0,0 +1,29 @@
+package uci
+
+import (
+	"os"
+	"testing"
+	"zerginator/board"
+	"zerginator/globals"
+)
+
+// TestMain initialises the attack tables and hash keys of the default board before the tests run
+func TestMain(m *testing.M) {
+	board.InitAttackTables()
+	board.InitRandomKeys()
+	os.Exit(m.Run())
+}
+
+func TestParsePositionRejectsMalformedCommands(t *testing.T) {
+	pos := board.NewPosition()
+	if err := pos.ParseFEN(globals.FenDebugStartPosition); err != nil {
+		t.Fatal(err)
+	}
+	fen := pos.ToFEN()
+	for _, command := range []string{"position", "pos", "position ", "positionx"} {
+		ParsePosition(pos, command)
+		if got := pos.ToFEN(); got != fen {
+			t.Errorf("ParsePosition(%q) changed the position to %q", command, got)
+		}
+	}
+}